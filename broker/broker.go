@@ -0,0 +1,542 @@
+// Package broker implements a small in-process MQTT 3.1.1 & 5 broker.
+//
+// It is not meant for production, but to run the sdk end-to-end
+// without any external service (tests, demos, simulator on a laptop):
+//
+//	b := broker.New()
+//	if err := b.Start("127.0.0.1:0"); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer b.Close()
+//
+//	api := sdk.New(sdk.ClientConfig{Host: b.Host(), Port: b.Port()}, false)
+//
+// Use WebsocketHandler to serve MQTT over WebSocket on http server.
+//
+// Topic wildcards, QoS 0/1/2, retained messages, last will and
+// persistent sessions (clean session = false) are supported.
+// MQTT 5 clients get properties of messages, message expiry and
+// shared subscriptions ("$share/{group}/{filter}"), session expiry is not enforced.
+package broker
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+)
+
+// SESSION_QUEUE_MAX is maximum message queued for offline persistent session.
+const SESSION_QUEUE_MAX = 1000
+
+// CONNECT_TIMEOUT is maximum time for client to send CONNECT packet.
+const CONNECT_TIMEOUT = 5 * time.Second
+
+var errBrokerClosed = errors.New("broker closed")
+
+// Message is an application message routed by broker.
+// Props is only published by & delivered to MQTT 5 clients.
+type Message struct {
+	Topic    string
+	Qos      byte
+	Retained bool
+	Payload  []byte
+	Props    mqtt5.Properties
+	// expires is set from MessageExpiry property when routed.
+	expires time.Time
+}
+
+// Handler receive message routed by broker, executed in the publisher goroutine.
+type Handler func(msg Message)
+
+type hook struct {
+	filter  string
+	handler Handler
+}
+
+// session store subscriptions of a client, it outlives the connection
+// when client connect with clean session = false.
+type session struct {
+	subs    map[string]byte
+	pending []Message
+	clean   bool
+}
+
+// Broker is in-process MQTT broker.
+type Broker struct {
+	// Auth validate username & password of connecting client.
+	// All clients are accepted when it is nil.
+	Auth func(user, pass string) bool
+	// Authorize validate topic filter subscribed by client, rejected filter is
+	// answered with failure return code. All filters are accepted when it is nil.
+	Authorize func(clientID, filter string) bool
+	// MaxPacketSize is maximum size of packet read from client, larger packet close
+	// the connection. It is mqtt5.DEFAULT_PACKET_SIZE when zero.
+	MaxPacketSize int
+
+	mu       sync.Mutex
+	ln       net.Listener
+	conns    map[string]*conn
+	sessions map[string]*session
+	retained map[string]Message
+	hooks    map[int]hook
+	hookID   int
+	clientID int
+	// shareNext is round robin counter of shared subscription filters.
+	shareNext map[string]int
+	wg        sync.WaitGroup
+}
+
+// New create new instance of broker, call Start or Serve to accept clients.
+func New() *Broker {
+	return &Broker{
+		conns:     map[string]*conn{},
+		sessions:  map[string]*session{},
+		retained:  map[string]Message{},
+		hooks:     map[int]hook{},
+		shareNext: map[string]int{},
+	}
+}
+
+// Start listen on tcp addr and serve clients in background.
+// Use port 0 to pick random free port, see Host & Port.
+func (b *Broker) Start(addr string) error {
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	if err := b.listen(ln); err != nil {
+		ln.Close()
+		return err
+	}
+	go b.accept(ln)
+	return nil
+}
+
+// Serve accept clients on ln until Close is called.
+// It allow broker to run on top of other transport (e.g. tls, websocket).
+func (b *Broker) Serve(ln net.Listener) error {
+	if err := b.listen(ln); err != nil {
+		return err
+	}
+	return b.accept(ln)
+}
+
+func (b *Broker) listen(ln net.Listener) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ln != nil {
+		return errors.New("broker already serving")
+	}
+	b.ln = ln
+	b.wg.Add(1)
+	return nil
+}
+
+func (b *Broker) accept(ln net.Listener) error {
+	defer b.wg.Done()
+
+	for {
+		nc, err := ln.Accept()
+		if err != nil {
+			b.mu.Lock()
+			closed := b.ln != ln
+			b.mu.Unlock()
+			if closed {
+				return errBrokerClosed
+			}
+			return err
+		}
+
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			newConn(b, nc).serve()
+		}()
+	}
+}
+
+// Addr return listening address, empty when not serving.
+func (b *Broker) Addr() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ln == nil {
+		return ""
+	}
+	return b.ln.Addr().String()
+}
+
+// Host return listening host.
+func (b *Broker) Host() string {
+	host, _, _ := net.SplitHostPort(b.Addr())
+	return host
+}
+
+// Port return listening port.
+func (b *Broker) Port() int {
+	_, port, _ := net.SplitHostPort(b.Addr())
+	n, _ := strconv.Atoi(port)
+	return n
+}
+
+// Close stop listening and drop all connected clients.
+// Retained messages & persistent sessions are kept,
+// so calling Start again act like a broker restart.
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	ln := b.ln
+	b.ln = nil
+	b.mu.Unlock()
+
+	if ln == nil {
+		return nil
+	}
+	err := ln.Close()
+	b.Disconnect()
+	b.wg.Wait()
+	return err
+}
+
+// Disconnect drop connection of clients abruptly (without DISCONNECT packet),
+// it simulate network loss so last will is published.
+// If clientIDs is empty, all clients are dropped.
+func (b *Broker) Disconnect(clientIDs ...string) {
+	b.mu.Lock()
+	var drops []*conn
+	if len(clientIDs) == 0 {
+		for _, c := range b.conns {
+			drops = append(drops, c)
+		}
+	}
+	for _, id := range clientIDs {
+		if c, ok := b.conns[id]; ok {
+			drops = append(drops, c)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, c := range drops {
+		c.close()
+	}
+}
+
+// Clients return id of connected clients.
+func (b *Broker) Clients() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ids := make([]string, 0, len(b.conns))
+	for id := range b.conns {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Subscribers return id of connected clients having subscription matched topic.
+func (b *Broker) Subscribers(topic string) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var ids []string
+	for id, c := range b.conns {
+		if _, ok := c.sess.match(topic); ok || len(c.sess.shared(topic)) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Retained return retained message of topic.
+func (b *Broker) Retained(topic string) (Message, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	msg, ok := b.retained[topic]
+	if ok && msg.expired() {
+		return Message{}, false
+	}
+	return msg, ok
+}
+
+// Publish route message to subscribers as if it is published by a client.
+func (b *Broker) Publish(topic string, qos byte, retained bool, payload []byte) error {
+	return b.PublishMessage(Message{
+		Topic:    topic,
+		Qos:      qos,
+		Retained: retained,
+		Payload:  append([]byte{}, payload...),
+	})
+}
+
+// PublishMessage route message with its properties, see Publish.
+func (b *Broker) PublishMessage(msg Message) error {
+	if !ValidTopic(msg.Topic) {
+		return fmt.Errorf("invalid topic %q", msg.Topic)
+	}
+	if msg.Qos > 2 {
+		return fmt.Errorf("invalid qos %d", msg.Qos)
+	}
+	msg.expires = time.Time{}
+	b.route(msg)
+	return nil
+}
+
+// Subscribe register in-process handler for messages matched filter,
+// call returned function to remove it.
+// Retained messages are not delivered to handler.
+func (b *Broker) Subscribe(filter string, handler Handler) (func(), error) {
+	if !ValidFilter(filter) {
+		return nil, fmt.Errorf("invalid filter %q", filter)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.hookID++
+	id := b.hookID
+	b.hooks[id] = hook{filter: filter, handler: handler}
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.hooks, id)
+	}, nil
+}
+
+// route deliver message to each subscriber once with max granted qos,
+// and to one member of each matched shared subscription.
+func (b *Broker) route(msg Message) {
+	if e := msg.Props.MessageExpiry; e != nil && msg.expires.IsZero() {
+		msg.expires = time.Now().Add(time.Duration(*e) * time.Second)
+	}
+
+	b.mu.Lock()
+	if msg.Retained {
+		if len(msg.Payload) == 0 {
+			delete(b.retained, msg.Topic)
+		} else {
+			b.retained[msg.Topic] = msg
+		}
+	}
+
+	type delivery struct {
+		c   *conn
+		qos byte
+	}
+	var deliveries []delivery
+	enqueue := func(id string, sess *session, qos byte) {
+		if c, online := b.conns[id]; online {
+			deliveries = append(deliveries, delivery{c: c, qos: qos})
+		} else if qos > 0 && msg.Qos > 0 && len(sess.pending) < SESSION_QUEUE_MAX {
+			sess.pending = append(sess.pending, msg.forward(qos))
+		}
+	}
+
+	shared := map[string][]string{}
+	for id, sess := range b.sessions {
+		if qos, ok := sess.match(msg.Topic); ok {
+			enqueue(id, sess, qos)
+		}
+		for _, filter := range sess.shared(msg.Topic) {
+			shared[filter] = append(shared[filter], id)
+		}
+	}
+	for filter, ids := range shared {
+		id := b.pickShared(filter, ids)
+		sess := b.sessions[id]
+		enqueue(id, sess, sess.subs[filter])
+	}
+
+	var handlers []Handler
+	for _, h := range b.hooks {
+		if Match(h.filter, msg.Topic) {
+			handlers = append(handlers, h.handler)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, d := range deliveries {
+		d.c.deliver(msg.forward(d.qos))
+	}
+	for _, h := range handlers {
+		h(msg)
+	}
+}
+
+// pickShared choose member of shared subscription in round robin,
+// online members are preferred. b.mu must be held.
+func (b *Broker) pickShared(filter string, ids []string) string {
+	sort.Strings(ids)
+	n := b.shareNext[filter]
+	b.shareNext[filter]++
+
+	for i := range ids {
+		id := ids[(n+i)%len(ids)]
+		if _, online := b.conns[id]; online {
+			return id
+		}
+	}
+	return ids[n%len(ids)]
+}
+
+// register attach connection to broker, taking over older connection
+// with same client id. Session is started over on clean start, and removed
+// on disconnect unless persist. It return whether old session is present,
+// and messages queued while client is offline.
+func (b *Broker) register(c *conn, cleanStart, persist bool) (bool, []Message) {
+	b.mu.Lock()
+	old := b.conns[c.id]
+	b.conns[c.id] = c
+
+	sess, present := b.sessions[c.id]
+	if !present || cleanStart || sess.clean {
+		sess = &session{subs: map[string]byte{}}
+		present = false
+	}
+	sess.clean = !persist
+	b.sessions[c.id] = sess
+	c.sess = sess
+
+	pending := sess.pending
+	sess.pending = nil
+	b.mu.Unlock()
+
+	if old != nil {
+		old.close()
+	}
+	return present, pending
+}
+
+// unregister detach connection from broker, clean session is removed.
+func (b *Broker) unregister(c *conn) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conns[c.id] != c {
+		return
+	}
+	delete(b.conns, c.id)
+	if c.sess.clean {
+		delete(b.sessions, c.id)
+	}
+}
+
+// subscribe add filters to client session and return granted qos,
+// retained messages are not sent to shared subscription.
+func (b *Broker) subscribe(c *conn, subs []mqtt5.Subscription) ([]byte, []Message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	granted := make([]byte, len(subs))
+	var retained []Message
+	for i, sub := range subs {
+		if !ValidFilter(sub.Filter) || sub.Qos > 2 {
+			granted[i] = mqtt5.REASON_FAILURE
+			continue
+		}
+		if b.Authorize != nil && !b.Authorize(c.id, sub.Filter) {
+			granted[i] = mqtt5.REASON_FAILURE
+			continue
+		}
+		c.sess.subs[sub.Filter] = sub.Qos
+		granted[i] = sub.Qos
+
+		if group, _ := mqtt5.SplitShare(sub.Filter); group != "" {
+			continue
+		}
+		for topic, msg := range b.retained {
+			if Match(sub.Filter, topic) {
+				msg := msg.forward(sub.Qos)
+				msg.Retained = true
+				retained = append(retained, msg)
+			}
+		}
+	}
+	return granted, retained
+}
+
+// unsubscribe remove filters from client session, and return reason code of each filter.
+func (b *Broker) unsubscribe(c *conn, filters []string) []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	codes := make([]byte, len(filters))
+	for i, filter := range filters {
+		if _, ok := c.sess.subs[filter]; !ok {
+			codes[i] = mqtt5.REASON_NO_SUBSCRIPTION
+		}
+		delete(c.sess.subs, filter)
+	}
+	return codes
+}
+
+// newClientID generate id for client connecting without one.
+func (b *Broker) newClientID() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.clientID++
+	return fmt.Sprintf("auto-%d", b.clientID)
+}
+
+// match return max qos of subscriptions matched topic, shared ones are excluded.
+func (s *session) match(topic string) (byte, bool) {
+	var qos byte
+	var ok bool
+	for filter, q := range s.subs {
+		if group, _ := mqtt5.SplitShare(filter); group != "" {
+			continue
+		}
+		if Match(filter, topic) {
+			ok = true
+			if q > qos {
+				qos = q
+			}
+		}
+	}
+	return qos, ok
+}
+
+// shared return shared subscription filters matched topic.
+func (s *session) shared(topic string) []string {
+	var filters []string
+	for filter := range s.subs {
+		if group, _ := mqtt5.SplitShare(filter); group != "" && Match(filter, topic) {
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
+
+// forward return copy of message to be delivered to subscription with granted qos.
+func (m Message) forward(qos byte) Message {
+	return Message{
+		Topic:   m.Topic,
+		Qos:     minQos(m.Qos, qos),
+		Payload: m.Payload,
+		Props:   m.Props,
+		expires: m.expires,
+	}
+}
+
+// expired report whether message expiry is passed.
+func (m Message) expired() bool {
+	return !m.expires.IsZero() && !time.Now().Before(m.expires)
+}
+
+func minQos(a, b byte) byte {
+	if a < b {
+		return a
+	}
+	return b
+}
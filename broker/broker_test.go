@@ -0,0 +1,482 @@
+package broker
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+)
+
+const testTimeout = 3 * time.Second
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		filter string
+		topic  string
+		want   bool
+	}{
+		{"VCU/+/RPT", "VCU/354313/RPT", true},
+		{"VCU/+/RPT", "VCU/354313/STS", false},
+		{"VCU/+/RPT", "VCU/354313/RPT/x", false},
+		{"VCU/#", "VCU/354313/RPT", true},
+		{"VCU/#", "VCU", true},
+		{"#", "VCU/354313/RPT", true},
+		{"+/+", "VCU/354313", true},
+		{"+", "VCU/354313", false},
+		{"VCU/354313/RPT", "VCU/354313/RPT", true},
+		{"VCU/354313/RPT", "VCU/354314/RPT", false},
+		{"#", "$SYS/uptime", false},
+		{"$SYS/#", "$SYS/uptime", true},
+	}
+	for _, tC := range testCases {
+		t.Run(fmt.Sprint(tC.filter, " ", tC.topic), func(t *testing.T) {
+			if got := Match(tC.filter, tC.topic); got != tC.want {
+				t.Errorf("want %v, got %v", tC.want, got)
+			}
+		})
+	}
+}
+
+func TestValidFilter(t *testing.T) {
+	testCases := map[string]bool{
+		"VCU/+/RPT": true,
+		"VCU/#":     true,
+		"#":         true,
+		"":          false,
+		"VCU/#/RPT": false,
+		"VCU/a#":    false,
+		"VCU/a+/b":  false,
+
+		"$share/g/VCU/+/RPT": true,
+		"$share/g":           false,
+		"$share//VCU/+/RPT":  false,
+		"$share/g+/VCU":      false,
+		"$share/g/VCU/#/RPT": false,
+	}
+	for filter, want := range testCases {
+		if got := ValidFilter(filter); got != want {
+			t.Errorf("filter %q, want %v, got %v", filter, want, got)
+		}
+	}
+}
+
+func TestBrokerWildcard(t *testing.T) {
+	b := newTestBroker(t)
+	sub := newTestClient(t, b, "sub", nil)
+	pub := newTestClient(t, b, "pub", nil)
+
+	got := make(chan string, 10)
+	subscribe(t, sub, "VCU/+/RPT", 1, func(c mqtt.Client, m mqtt.Message) {
+		got <- m.Topic()
+	})
+
+	for _, topic := range []string{"VCU/1/STS", "VCU/2/RPT", "VCU/3/RPT/x", "VCU/4/RPT"} {
+		publish(t, pub, topic, 1, false, "x")
+	}
+
+	for _, want := range []string{"VCU/2/RPT", "VCU/4/RPT"} {
+		select {
+		case topic := <-got:
+			if topic != want {
+				t.Errorf("want %s, got %s", want, topic)
+			}
+		case <-time.After(testTimeout):
+			t.Fatal("want message on ", want)
+		}
+	}
+	select {
+	case topic := <-got:
+		t.Error("want no more message, got ", topic)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestBrokerQos(t *testing.T) {
+	for qos := byte(0); qos <= 2; qos++ {
+		t.Run(fmt.Sprint("qos ", qos), func(t *testing.T) {
+			b := newTestBroker(t)
+			sub := newTestClient(t, b, "sub", nil)
+			pub := newTestClient(t, b, "pub", nil)
+
+			got := make(chan mqtt.Message, 10)
+			subscribe(t, sub, "VCU/#", 2, func(c mqtt.Client, m mqtt.Message) {
+				got <- m
+			})
+			publish(t, pub, "VCU/1/CMD", qos, false, "hello")
+
+			select {
+			case m := <-got:
+				if m.Qos() != qos {
+					t.Errorf("want qos %d, got %d", qos, m.Qos())
+				}
+				if string(m.Payload()) != "hello" {
+					t.Errorf("want %s, got %s", "hello", m.Payload())
+				}
+			case <-time.After(testTimeout):
+				t.Fatal("want message delivered")
+			}
+		})
+	}
+}
+
+func TestBrokerRetained(t *testing.T) {
+	b := newTestBroker(t)
+	pub := newTestClient(t, b, "pub", nil)
+	publish(t, pub, "VCU/1/STS", 1, true, "1")
+
+	if _, ok := b.Retained("VCU/1/STS"); !ok {
+		t.Fatal("want retained message stored")
+	}
+
+	sub := newTestClient(t, b, "sub", nil)
+	got := make(chan mqtt.Message, 1)
+	subscribe(t, sub, "VCU/+/STS", 1, func(c mqtt.Client, m mqtt.Message) {
+		got <- m
+	})
+	select {
+	case m := <-got:
+		if !m.Retained() || string(m.Payload()) != "1" {
+			t.Errorf("want retained %s, got %v %s", "1", m.Retained(), m.Payload())
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("want retained message delivered")
+	}
+
+	// empty payload clear retained message
+	publish(t, pub, "VCU/1/STS", 1, true, "")
+	if _, ok := b.Retained("VCU/1/STS"); ok {
+		t.Error("want retained message cleared")
+	}
+}
+
+func TestBrokerWill(t *testing.T) {
+	b := newTestBroker(t)
+
+	got := make(chan Message, 1)
+	cancel, err := b.Subscribe("VCU/+/STS", func(msg Message) {
+		got <- msg
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cancel()
+
+	newTestClient(t, b, "vcu", func(o *mqtt.ClientOptions) {
+		o.SetWill("VCU/1/STS", "0", 1, true)
+		o.SetAutoReconnect(false)
+	})
+	b.Disconnect("vcu")
+
+	select {
+	case msg := <-got:
+		if string(msg.Payload) != "0" {
+			t.Errorf("want %s, got %s", "0", msg.Payload)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("want will published")
+	}
+}
+
+func TestBrokerPersistentSession(t *testing.T) {
+	b := newTestBroker(t)
+	options := func(o *mqtt.ClientOptions) {
+		o.SetCleanSession(false)
+		o.SetAutoReconnect(false)
+	}
+
+	sub := newTestClient(t, b, "sub", options)
+	subscribe(t, sub, "VCU/+/RSP", 1, nil)
+	sub.Disconnect(0)
+	waitFor(t, func() bool { return len(b.Clients()) == 0 })
+
+	if err := b.Publish("VCU/1/RSP", 1, false, []byte("queued")); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	got := make(chan string, 1)
+	newTestClient(t, b, "sub", func(o *mqtt.ClientOptions) {
+		options(o)
+		o.SetDefaultPublishHandler(func(c mqtt.Client, m mqtt.Message) {
+			got <- string(m.Payload())
+		})
+	})
+	select {
+	case payload := <-got:
+		if payload != "queued" {
+			t.Errorf("want %s, got %s", "queued", payload)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("want queued message delivered")
+	}
+}
+
+func TestBrokerRestart(t *testing.T) {
+	b := newTestBroker(t)
+	addr := b.Addr()
+
+	c := newTestClient(t, b, "sub", func(o *mqtt.ClientOptions) {
+		o.SetCleanSession(false)
+		o.SetAutoReconnect(false)
+	})
+	subscribe(t, c, "VCU/+/RPT", 1, nil)
+
+	if err := b.Close(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	waitFor(t, func() bool { return !c.IsConnectionOpen() })
+
+	if err := b.Start(addr); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	token := c.Connect()
+	if !token.WaitTimeout(testTimeout) || token.Error() != nil {
+		t.Fatal("want reconnected, got ", token.Error())
+	}
+	if !token.(*mqtt.ConnectToken).SessionPresent() {
+		t.Error("want session present after restart")
+	}
+	if want, got := []string{"sub"}, b.Subscribers("VCU/1/RPT"); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestBrokerAuth(t *testing.T) {
+	b := newTestBroker(t)
+	b.Auth = func(user, pass string) bool {
+		return user == "vcu" && pass == "secret"
+	}
+
+	opts := mqtt.NewClientOptions().
+		AddBroker("tcp://" + b.Addr()).
+		SetUsername("vcu").
+		SetPassword("wrong")
+	token := mqtt.NewClient(opts).Connect()
+	if !token.WaitTimeout(testTimeout) || token.Error() == nil {
+		t.Error("want connection refused")
+	}
+
+	newTestClient(t, b, "vcu", func(o *mqtt.ClientOptions) {
+		o.SetUsername("vcu")
+		o.SetPassword("secret")
+	})
+}
+
+func TestBrokerAuthorize(t *testing.T) {
+	b := newTestBroker(t)
+	b.Authorize = func(clientID, filter string) bool {
+		return filter != "VCU/#"
+	}
+
+	c := newTestClient(t, b, "vcu", nil)
+	token := c.SubscribeMultiple(map[string]byte{"VCU/#": 1, "VCU/+/RPT": 1}, nil)
+	if !token.WaitTimeout(testTimeout) || token.Error() != nil {
+		t.Fatal("want subscribed, got ", token.Error())
+	}
+
+	result := token.(*mqtt.SubscribeToken).Result()
+	if got := result["VCU/#"]; got != 0x80 {
+		t.Errorf("want %#x, got %#x", 0x80, got)
+	}
+	if got := result["VCU/+/RPT"]; got != 1 {
+		t.Errorf("want %d, got %d", 1, got)
+	}
+}
+
+func TestBrokerMQTT5Properties(t *testing.T) {
+	b := newTestBroker(t)
+	sub5 := newTestClient5(t, b, "sub5")
+	sub3 := newTestClient(t, b, "sub3", nil)
+	pub := newTestClient5(t, b, "pub")
+
+	got5 := make(chan mqtt.Message, 1)
+	subscribe(t, sub5, "VCU/+/CMD", 1, func(c mqtt.Client, m mqtt.Message) {
+		got5 <- m
+	})
+	got3 := make(chan mqtt.Message, 1)
+	subscribe(t, sub3, "VCU/+/CMD", 1, func(c mqtt.Client, m mqtt.Message) {
+		got3 <- m
+	})
+
+	props := mqtt5.Properties{
+		MessageExpiry:   mqtt5.Uint32(10),
+		ResponseTopic:   "VCU/1/RSP",
+		CorrelationData: []byte{1, 2},
+	}
+	props.Add("key", "value")
+	token := pub.Publish("VCU/1/CMD", 1, false, mqtt5.Payload{Data: []byte("x"), Props: props})
+	if !token.WaitTimeout(testTimeout) || token.Error() != nil {
+		t.Fatal("want published, got ", token.Error())
+	}
+
+	select {
+	case m := <-got5:
+		got := m.(*mqtt5.Message).Properties()
+		if got.ResponseTopic != props.ResponseTopic || !reflect.DeepEqual(got.CorrelationData, props.CorrelationData) {
+			t.Errorf("want %+v, got %+v", props, got)
+		}
+		if v, _ := got.Get("key"); v != "value" {
+			t.Errorf("want %s, got %s", "value", v)
+		}
+		if got.MessageExpiry == nil || *got.MessageExpiry == 0 || *got.MessageExpiry > 10 {
+			t.Errorf("want expiry within 10s, got %v", got.MessageExpiry)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("want message on mqtt 5 client")
+	}
+	select {
+	case m := <-got3:
+		if string(m.Payload()) != "x" {
+			t.Errorf("want %s, got %s", "x", m.Payload())
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("want message on mqtt 3.1.1 client")
+	}
+}
+
+func TestBrokerMessageExpiry(t *testing.T) {
+	b := newTestBroker(t)
+	pub := newTestClient5(t, b, "pub")
+
+	token := pub.Publish("VCU/1/CMD", 1, true, mqtt5.Payload{
+		Data:  []byte("x"),
+		Props: mqtt5.Properties{MessageExpiry: mqtt5.Uint32(1)},
+	})
+	if !token.WaitTimeout(testTimeout) || token.Error() != nil {
+		t.Fatal("want published, got ", token.Error())
+	}
+	if _, ok := b.Retained("VCU/1/CMD"); !ok {
+		t.Fatal("want retained message stored")
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+	if _, ok := b.Retained("VCU/1/CMD"); ok {
+		t.Error("want retained message expired")
+	}
+
+	sub := newTestClient5(t, b, "sub")
+	got := make(chan mqtt.Message, 1)
+	subscribe(t, sub, "VCU/+/CMD", 1, func(c mqtt.Client, m mqtt.Message) {
+		got <- m
+	})
+	select {
+	case m := <-got:
+		t.Errorf("want no expired message, got %s", m.Payload())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestBrokerSharedSubscription(t *testing.T) {
+	b := newTestBroker(t)
+	pub := newTestClient(t, b, "pub", nil)
+
+	got := make(chan string, 10)
+	for _, id := range []string{"a", "b"} {
+		id := id
+		c := newTestClient5(t, b, id)
+		subscribe(t, c, "$share/g/VCU/+/RPT", 1, func(c mqtt.Client, m mqtt.Message) {
+			got <- id
+		})
+	}
+
+	for i := 0; i < 4; i++ {
+		publish(t, pub, "VCU/1/RPT", 1, false, "x")
+	}
+
+	counts := map[string]int{}
+	for i := 0; i < 4; i++ {
+		select {
+		case id := <-got:
+			counts[id]++
+		case <-time.After(testTimeout):
+			t.Fatal("want message delivered to group")
+		}
+	}
+	if want := map[string]int{"a": 2, "b": 2}; !reflect.DeepEqual(want, counts) {
+		t.Errorf("want %v, got %v", want, counts)
+	}
+	select {
+	case id := <-got:
+		t.Error("want each message delivered once, got extra on ", id)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func newTestBroker(t *testing.T) *Broker {
+	t.Helper()
+
+	b := New()
+	if err := b.Start(""); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { b.Close() })
+	return b
+}
+
+func newTestClient(t *testing.T, b *Broker, id string, modifier func(o *mqtt.ClientOptions)) mqtt.Client {
+	t.Helper()
+
+	opts := mqtt.NewClientOptions().
+		AddBroker("tcp://" + b.Addr()).
+		SetClientID(id)
+	if modifier != nil {
+		modifier(opts)
+	}
+
+	c := mqtt.NewClient(opts)
+	token := c.Connect()
+	if !token.WaitTimeout(testTimeout) || token.Error() != nil {
+		t.Fatal("want connected, got ", token.Error())
+	}
+	t.Cleanup(func() { c.Disconnect(0) })
+	return c
+}
+
+func newTestClient5(t *testing.T, b *Broker, id string) mqtt.Client {
+	t.Helper()
+
+	opts := mqtt.NewClientOptions().
+		AddBroker("tcp://" + b.Addr()).
+		SetClientID(id)
+
+	c := mqtt5.NewClient(opts)
+	token := c.Connect()
+	if !token.WaitTimeout(testTimeout) || token.Error() != nil {
+		t.Fatal("want connected, got ", token.Error())
+	}
+	t.Cleanup(func() { c.Disconnect(0) })
+	return c
+}
+
+func subscribe(t *testing.T, c mqtt.Client, filter string, qos byte, handler mqtt.MessageHandler) {
+	t.Helper()
+
+	token := c.Subscribe(filter, qos, handler)
+	if !token.WaitTimeout(testTimeout) || token.Error() != nil {
+		t.Fatal("want subscribed, got ", token.Error())
+	}
+}
+
+func publish(t *testing.T, c mqtt.Client, topic string, qos byte, retained bool, payload string) {
+	t.Helper()
+
+	token := c.Publish(topic, qos, retained, payload)
+	if !token.WaitTimeout(testTimeout) || token.Error() != nil {
+		t.Fatal("want published, got ", token.Error())
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(testTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("want condition met before timeout")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
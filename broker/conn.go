@@ -0,0 +1,289 @@
+package broker
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+)
+
+// OUTBOX_SIZE is buffered outgoing packets per client.
+const OUTBOX_SIZE = 256
+
+// MQTT 3.1.1 CONNACK return codes.
+const (
+	connRefusedVersion       byte = 0x01
+	connRefusedIDRejected    byte = 0x02
+	connRefusedNotAuthorised byte = 0x05
+)
+
+// reasonDisconnectWithWill is DISCONNECT reason asking broker to publish the will.
+const reasonDisconnectWithWill byte = 0x04
+
+// conn is a client connection.
+type conn struct {
+	broker  *Broker
+	nc      net.Conn
+	id      string
+	version byte
+	sess    *session
+	will    *Message
+
+	outbox chan mqtt5.Packet
+	done   chan struct{}
+	once   sync.Once
+
+	mu       sync.Mutex
+	msgID    uint16
+	received map[uint16]bool // qos 2 message id waiting for PUBREL
+}
+
+func newConn(b *Broker, nc net.Conn) *conn {
+	return &conn{
+		broker:   b,
+		nc:       nc,
+		outbox:   make(chan mqtt5.Packet, OUTBOX_SIZE),
+		done:     make(chan struct{}),
+		received: map[uint16]bool{},
+	}
+}
+
+// serve handle connection until it is closed.
+func (c *conn) serve() {
+	defer c.close()
+
+	go c.writeLoop()
+
+	keepAlive, ok := c.connect()
+	if !ok {
+		return
+	}
+	defer c.disconnected()
+
+	for {
+		if keepAlive > 0 {
+			c.nc.SetReadDeadline(time.Now().Add(keepAlive * 3 / 2))
+		}
+		cp, err := mqtt5.ReadPacket(c.nc, c.version, c.broker.MaxPacketSize)
+		if err != nil {
+			return
+		}
+		if !c.handle(cp) {
+			return
+		}
+	}
+}
+
+// connect handle CONNECT packet of MQTT 3.1.1 or 5, and register client to broker.
+func (c *conn) connect() (time.Duration, bool) {
+	c.nc.SetReadDeadline(time.Now().Add(CONNECT_TIMEOUT))
+	cp, err := mqtt5.ReadPacket(c.nc, 0, c.broker.MaxPacketSize)
+	if err == mqtt5.ErrUnsupportedVersion {
+		c.refuse(connRefusedVersion, mqtt5.REASON_UNSUPPORTED_VERSION)
+		return 0, false
+	}
+	if err != nil {
+		return 0, false
+	}
+	p, ok := cp.(*mqtt5.Connect)
+	if !ok {
+		return 0, false
+	}
+	c.version = p.Version
+
+	if p.ClientID == "" && !p.CleanStart && c.version != mqtt5.VERSION_5 {
+		c.refuse(connRefusedIDRejected, mqtt5.REASON_CLIENT_ID_INVALID)
+		return 0, false
+	}
+	if c.broker.Auth != nil && !c.broker.Auth(p.Username, string(p.Password)) {
+		c.refuse(connRefusedNotAuthorised, mqtt5.REASON_NOT_AUTHORIZED)
+		return 0, false
+	}
+
+	ack := &mqtt5.Connack{}
+	c.id = p.ClientID
+	if c.id == "" {
+		c.id = c.broker.newClientID()
+		ack.Props.AssignedClientID = c.id
+	}
+	if w := p.Will; w != nil {
+		c.will = &Message{
+			Topic:    w.Topic,
+			Qos:      w.Qos,
+			Retained: w.Retain,
+			Payload:  w.Payload,
+			Props:    w.Props,
+		}
+	}
+
+	// MQTT 5 session outlive connection when it has expiry, which is not enforced
+	persist := !p.CleanStart
+	if c.version == mqtt5.VERSION_5 {
+		persist = p.Props.SessionExpiry != nil && *p.Props.SessionExpiry > 0
+	}
+	present, pending := c.broker.register(c, p.CleanStart, persist)
+
+	ack.SessionPresent = present
+	c.send(ack)
+	for _, msg := range pending {
+		c.deliver(msg)
+	}
+
+	c.nc.SetReadDeadline(time.Time{})
+	return time.Duration(p.KeepAlive) * time.Second, true
+}
+
+// refuse reply CONNACK with return code of MQTT 3.1.1 or reason code of MQTT 5.
+func (c *conn) refuse(code311, code5 byte) {
+	ack := &mqtt5.Connack{ReasonCode: code311}
+	if c.version == mqtt5.VERSION_5 {
+		ack.ReasonCode = code5
+	}
+	// nothing is queued yet, safe to write directly before closing
+	mqtt5.WritePacket(c.nc, ack, c.version)
+}
+
+// handle process incoming packet, return false to close connection.
+func (c *conn) handle(cp mqtt5.Packet) bool {
+	switch p := cp.(type) {
+	case *mqtt5.Publish:
+		msg := Message{
+			Topic:    p.Topic,
+			Qos:      p.Qos,
+			Retained: p.Retain,
+			Payload:  p.Payload,
+			Props:    p.Props,
+		}
+		if !ValidTopic(msg.Topic) || msg.Qos > 2 {
+			return false
+		}
+
+		switch p.Qos {
+		case 1:
+			c.send(&mqtt5.Ack{Kind: mqtt5.PUBACK, PacketID: p.PacketID})
+		case 2:
+			c.mu.Lock()
+			dup := c.received[p.PacketID]
+			c.received[p.PacketID] = true
+			c.mu.Unlock()
+
+			c.send(&mqtt5.Ack{Kind: mqtt5.PUBREC, PacketID: p.PacketID})
+			if dup {
+				return true
+			}
+		}
+		c.broker.route(msg)
+
+	case *mqtt5.Ack:
+		switch p.Kind {
+		case mqtt5.PUBREL:
+			c.mu.Lock()
+			delete(c.received, p.PacketID)
+			c.mu.Unlock()
+
+			c.send(&mqtt5.Ack{Kind: mqtt5.PUBCOMP, PacketID: p.PacketID})
+		case mqtt5.PUBREC:
+			c.send(&mqtt5.Ack{Kind: mqtt5.PUBREL, PacketID: p.PacketID})
+		default:
+			// outgoing message is not re-delivered, nothing to release
+		}
+
+	case *mqtt5.Subscribe:
+		granted, retained := c.broker.subscribe(c, p.Subscriptions)
+		c.send(&mqtt5.Suback{PacketID: p.PacketID, ReasonCodes: granted})
+
+		for _, msg := range retained {
+			c.deliver(msg)
+		}
+
+	case *mqtt5.Unsubscribe:
+		codes := c.broker.unsubscribe(c, p.Filters)
+		c.send(&mqtt5.Unsuback{PacketID: p.PacketID, ReasonCodes: codes})
+
+	case *mqtt5.Pingreq:
+		c.send(&mqtt5.Pingresp{})
+
+	case *mqtt5.Disconnect:
+		if p.ReasonCode != reasonDisconnectWithWill {
+			c.will = nil
+		}
+		return false
+
+	default:
+		return false
+	}
+	return true
+}
+
+// deliver send message to client, expired message is dropped
+// and expiry of the others is reduced by time spent on broker.
+func (c *conn) deliver(msg Message) {
+	p := &mqtt5.Publish{
+		Topic:   msg.Topic,
+		Qos:     msg.Qos,
+		Retain:  msg.Retained,
+		Payload: msg.Payload,
+		Props:   msg.Props,
+	}
+	if !msg.expires.IsZero() {
+		left := time.Until(msg.expires)
+		if left <= 0 {
+			return
+		}
+		p.Props.MessageExpiry = mqtt5.Uint32(uint32((left + time.Second - 1) / time.Second))
+	}
+	if p.Qos > 0 {
+		p.PacketID = c.nextID()
+	}
+	c.send(p)
+}
+
+// send queue packet to be written, dropped when connection is closed.
+func (c *conn) send(cp mqtt5.Packet) {
+	select {
+	case c.outbox <- cp:
+	case <-c.done:
+	}
+}
+
+func (c *conn) writeLoop() {
+	for {
+		select {
+		case cp := <-c.outbox:
+			if err := mqtt5.WritePacket(c.nc, cp, c.version); err != nil {
+				c.close()
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *conn) nextID() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.msgID++
+	if c.msgID == 0 {
+		c.msgID = 1
+	}
+	return c.msgID
+}
+
+// disconnected unregister client and publish the will if any.
+func (c *conn) disconnected() {
+	c.broker.unregister(c)
+	if c.will != nil {
+		c.broker.route(*c.will)
+	}
+}
+
+// close terminate connection, safe to call multiple times.
+func (c *conn) close() {
+	c.once.Do(func() {
+		close(c.done)
+		c.nc.Close()
+	})
+}
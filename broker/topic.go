@@ -0,0 +1,45 @@
+package broker
+
+import (
+	"strings"
+
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+)
+
+// Match report whether topic name is matched by topic filter,
+// supporting single level (+) and multi level (#) wildcards.
+// Topic beginning with '$' never match filter starting with wildcard.
+// Shared subscription filter is matched by its topic filter.
+func Match(filter, topic string) bool {
+	return mqtt5.Match(filter, topic)
+}
+
+// ValidFilter report whether filter is a legal topic filter,
+// or shared subscription filter "$share/{group}/{filter}".
+func ValidFilter(filter string) bool {
+	if strings.HasPrefix(filter, mqtt5.SHARE_PREFIX) {
+		group, topicFilter := mqtt5.SplitShare(filter)
+		if !mqtt5.ValidGroup(group) {
+			return false
+		}
+		filter = topicFilter
+	}
+	if filter == "" {
+		return false
+	}
+	levels := strings.Split(filter, "/")
+	for i, l := range levels {
+		if strings.Contains(l, "#") && (l != "#" || i != len(levels)-1) {
+			return false
+		}
+		if strings.Contains(l, "+") && l != "+" {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidTopic report whether topic is a legal topic name to publish.
+func ValidTopic(topic string) bool {
+	return topic != "" && !strings.ContainsAny(topic, "+#")
+}
@@ -0,0 +1,26 @@
+package broker
+
+import (
+	"net/http"
+
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+	"github.com/gorilla/websocket"
+)
+
+// WebsocketHandler serve MQTT over WebSocket ("mqtt" subprotocol), to be mounted
+// on the path of client broker url, ex: http.Handle("/mqtt", b.WebsocketHandler()).
+// Clients are dropped on Close like tcp clients.
+func (b *Broker) WebsocketHandler() http.Handler {
+	upgrader := websocket.Upgrader{
+		Subprotocols: []string{"mqtt"},
+		CheckOrigin:  func(r *http.Request) bool { return true },
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// upgrader already replied with error
+			return
+		}
+		newConn(b, mqtt5.NewWebsocketConn(ws)).serve()
+	})
+}
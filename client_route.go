@@ -0,0 +1,190 @@
+package sdk
+
+import (
+	"sync"
+	"sync/atomic"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+)
+
+// route is listener registered on a topic, id identify its owner.
+type route struct {
+	id uint64
+	ls *Listener
+}
+
+// topicRoutes is listeners of a topic, with template to extract VIN.
+type topicRoutes struct {
+	template topicTemplate
+	report   bool
+	routes   []route
+}
+
+// routes is handlers of each subscribed topic, so several listeners share one subscription.
+type routes struct {
+	// subMu serialize add & remove, which wait for (un)subscribe.
+	subMu  sync.Mutex
+	mu     sync.RWMutex
+	lastID uint64
+	topics map[string]*topicRoutes
+}
+
+// nextID return unique id of routes owner.
+func (r *routes) nextID() uint64 {
+	return atomic.AddUint64(&r.lastID, 1)
+}
+
+// listeners return copy of topic routes, it is false when topic has no route.
+func (r *routes) listeners(topic string) (topicRoutes, []*Listener, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tr, ok := r.topics[topic]
+	if !ok {
+		return topicRoutes{}, nil, false
+	}
+	lss := make([]*Listener, len(tr.routes))
+	for i, rt := range tr.routes {
+		lss[i] = rt.ls
+	}
+	return *tr, lss, true
+}
+
+// add register listener on topics, and return topics which had no route.
+func (r *routes) add(topics []string, tpl topicTemplate, report bool, id uint64, ls *Listener) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.topics == nil {
+		r.topics = map[string]*topicRoutes{}
+	}
+	var fresh []string
+	for _, topic := range topics {
+		tr, ok := r.topics[topic]
+		if !ok {
+			tr = &topicRoutes{template: tpl, report: report}
+			r.topics[topic] = tr
+			fresh = append(fresh, topic)
+		}
+		tr.routes = append(tr.routes, route{id: id, ls: ls})
+	}
+	return fresh
+}
+
+// remove unregister handlers of id from topics, and return topics left without route.
+func (r *routes) remove(topics []string, id uint64) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var empty []string
+	for _, topic := range topics {
+		tr, ok := r.topics[topic]
+		if !ok {
+			continue
+		}
+
+		left := tr.routes[:0:0]
+		for _, rt := range tr.routes {
+			if rt.id != id {
+				left = append(left, rt)
+			}
+		}
+		if len(left) > 0 {
+			tr.routes = left
+			continue
+		}
+		delete(r.topics, topic)
+		empty = append(empty, topic)
+	}
+	return empty
+}
+
+// addRoute register listener on status (or report) topics of tpl, topics without route yet
+// are subscribed with qos. Topics already subscribed are not subscribed again,
+// so retained message is not re-delivered.
+func (c *client) addRoute(topics []string, qos byte, tpl topicTemplate, report bool, id uint64, ls *Listener) error {
+	c.routes.subMu.Lock()
+	defer c.routes.subMu.Unlock()
+
+	// route is registered first, so pending messages flushed on subscribe are dispatched
+	fresh := c.routes.add(topics, tpl, report, id, ls)
+
+	var err error
+	switch len(fresh) {
+	case 0:
+	case 1:
+		err = c.sub(fresh[0], qos, c.dispatch(fresh))
+	default:
+		err = c.subMulti(fresh, qos, c.dispatch(fresh))
+	}
+	if err != nil {
+		c.routes.remove(topics, id)
+	}
+	return err
+}
+
+// removeRoute unregister handlers of id from topics,
+// topics left without handler are unsubscribed.
+func (c *client) removeRoute(topics []string, id uint64) error {
+	c.routes.subMu.Lock()
+	defer c.routes.subMu.Unlock()
+
+	empty := c.routes.remove(topics, id)
+	if len(empty) == 0 {
+		return nil
+	}
+	return c.unsub(empty)
+}
+
+// dropRoute unregister all handlers of topics and unsubscribe them.
+func (c *client) dropRoute(topics []string) error {
+	c.routes.subMu.Lock()
+	defer c.routes.subMu.Unlock()
+
+	c.routes.mu.Lock()
+	for _, topic := range topics {
+		delete(c.routes.topics, topic)
+	}
+	c.routes.mu.Unlock()
+	return c.unsub(topics)
+}
+
+// dispatch return handler of subscribed topics, it pass message to every listener
+// of the topic filter matching it. Report is decoded & observed once for all of them.
+func (c *client) dispatch(topics []string) mqtt.MessageHandler {
+	exact := make(map[string]string, len(topics))
+	for _, topic := range topics {
+		_, filter := mqtt5.SplitShare(topic)
+		exact[filter] = topic
+	}
+
+	return func(client mqtt.Client, msg mqtt.Message) {
+		topic, ok := exact[msg.Topic()]
+		if !ok {
+			for _, t := range topics {
+				if mqtt5.Match(t, msg.Topic()) {
+					topic, ok = t, true
+					break
+				}
+			}
+		}
+		if !ok {
+			return
+		}
+
+		tr, lss, ok := c.routes.listeners(topic)
+		if !ok {
+			return
+		}
+		c.logger.packet(RPT, msg)
+		vin, _ := tr.template.vin(msg.Topic())
+		c.run(vin, func() {
+			if tr.report {
+				handleReport(c, c.logger, vin, msg, lss)
+			} else {
+				handleStatus(vin, msg, lss)
+			}
+		})
+	}
+}
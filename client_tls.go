@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+)
+
+// TLSConfig configure secure protocols ("ssl", "tls", "mqtts", "wss").
+// Each PEM is read from file or given as bytes, not both.
+// Files are re-read on every connection attempt (including auto reconnect),
+// so renewed certificates are used without restart.
+type TLSConfig struct {
+	// CAFile or CA is bundle of trusted CAs, system roots are used if both empty.
+	CAFile string
+	CA     []byte
+	// CertFile & KeyFile (or Cert & Key) is client certificate for mutual TLS.
+	CertFile string
+	KeyFile  string
+	Cert     []byte
+	Key      []byte
+	// ServerName verify broker certificate, default is Host.
+	ServerName string
+	// InsecureSkipVerify accept any broker certificate, for testing only.
+	InsecureSkipVerify bool
+}
+
+// tlsLoader build tls.Config from TLSConfig, keeping the last good one.
+type tlsLoader struct {
+	cfg        TLSConfig
+	serverName string
+	logger     *logger
+
+	mutex sync.Mutex
+	last  *tls.Config
+}
+
+func newTLSLoader(cfg TLSConfig, host string, logger *logger) (*tlsLoader, error) {
+	for _, pem := range []struct {
+		name string
+		file string
+		b    []byte
+	}{
+		{"tls ca", cfg.CAFile, cfg.CA},
+		{"tls cert", cfg.CertFile, cfg.Cert},
+		{"tls key", cfg.KeyFile, cfg.Key},
+	} {
+		if pem.file != "" && len(pem.b) > 0 {
+			return nil, errInvalidConfig(pem.name + ", both file & bytes")
+		}
+	}
+	if (cfg.CertFile != "" || len(cfg.Cert) > 0) != (cfg.KeyFile != "" || len(cfg.Key) > 0) {
+		return nil, errInvalidConfig("tls, cert & key must be paired")
+	}
+
+	l := &tlsLoader{
+		cfg:        cfg,
+		serverName: cfg.ServerName,
+		logger:     logger,
+	}
+	if l.serverName == "" {
+		l.serverName = host
+	}
+	if _, err := l.load(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// load read PEMs and build new tls.Config.
+func (l *tlsLoader) load() (*tls.Config, error) {
+	conf := &tls.Config{
+		ServerName:         l.serverName,
+		InsecureSkipVerify: l.cfg.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	ca, err := readPEM(l.cfg.CAFile, l.cfg.CA)
+	if err != nil {
+		return nil, err
+	}
+	if len(ca) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errInvalidConfig("tls ca, no certificate found")
+		}
+		conf.RootCAs = pool
+	}
+
+	cert, err := readPEM(l.cfg.CertFile, l.cfg.Cert)
+	if err != nil {
+		return nil, err
+	}
+	key, err := readPEM(l.cfg.KeyFile, l.cfg.Key)
+	if err != nil {
+		return nil, err
+	}
+	if len(cert) > 0 {
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("tls client certificate: %w", err)
+		}
+		conf.Certificates = []tls.Certificate{pair}
+	}
+
+	l.mutex.Lock()
+	l.last = conf
+	l.mutex.Unlock()
+	return conf, nil
+}
+
+// attempt is paho connection attempt handler, it reload the certificates
+// and fallback to the last good config on error.
+func (l *tlsLoader) attempt(broker *url.URL, _ *tls.Config) *tls.Config {
+	conf, err := l.load()
+	if err == nil {
+		return conf
+	}
+	l.logger.error(CLI, "TLS reload failed", "err", err)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.last
+}
+
+func readPEM(file string, b []byte) ([]byte, error) {
+	if file == "" {
+		return b, nil
+	}
+	return os.ReadFile(file)
+}
@@ -0,0 +1,273 @@
+package sdk
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/garda-energi/gen.vcu.sdk/broker"
+)
+
+func TestClientTLS(t *testing.T) {
+	ca := newTestCA(t)
+	other := newTestCA(t)
+	host, port := newTLSBroker(t, ca, ca)
+	cert, key := ca.issue(t, "client", false)
+	otherCert, otherKey := other.issue(t, "client", false)
+
+	testCases := []struct {
+		desc    string
+		tls     TLSConfig
+		success bool
+	}{
+		{
+			desc:    "mutual tls from files",
+			tls:     TLSConfig{CAFile: ca.file(t), CertFile: writeTemp(t, cert), KeyFile: writeTemp(t, key)},
+			success: true,
+		},
+		{
+			desc:    "mutual tls from bytes",
+			tls:     TLSConfig{CA: ca.pem, Cert: cert, Key: key},
+			success: true,
+		},
+		{
+			desc: "no client certificate",
+			tls:  TLSConfig{CA: ca.pem},
+		},
+		{
+			desc: "client certificate of unknown ca",
+			tls:  TLSConfig{CA: ca.pem, Cert: otherCert, Key: otherKey},
+		},
+		{
+			desc: "unknown broker ca",
+			tls:  TLSConfig{CA: other.pem, Cert: cert, Key: key},
+		},
+		{
+			desc: "server name mismatch",
+			tls:  TLSConfig{CA: ca.pem, Cert: cert, Key: key, ServerName: "broker.example"},
+		},
+		{
+			desc:    "insecure skip verify",
+			tls:     TLSConfig{Cert: cert, Key: key, InsecureSkipVerify: true},
+			success: true,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			tlsConfig := tC.tls
+			api := New(ClientConfig{Host: host, Port: port, TLS: &tlsConfig}, false)
+			err := api.Connect()
+			defer api.Disconnect()
+
+			if tC.success && err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			if !tC.success && err == nil {
+				t.Fatal("want error, got nil")
+			}
+		})
+	}
+}
+
+func TestClientTLSInvalid(t *testing.T) {
+	ca := newTestCA(t)
+	cert, key := ca.issue(t, "client", false)
+
+	testCases := []struct {
+		desc     string
+		protocol string
+		tls      TLSConfig
+		want     error
+	}{
+		{
+			desc:     "plain protocol",
+			protocol: "tcp",
+			tls:      TLSConfig{CA: ca.pem},
+			want:     errInvalidConfig("tls on tcp protocol"),
+		},
+		{
+			desc: "both file & bytes",
+			tls:  TLSConfig{CA: ca.pem, CAFile: ca.file(t)},
+			want: errInvalidConfig("tls ca, both file & bytes"),
+		},
+		{
+			desc: "cert without key",
+			tls:  TLSConfig{Cert: cert},
+			want: errInvalidConfig("tls, cert & key must be paired"),
+		},
+		{
+			desc: "no ca certificate",
+			tls:  TLSConfig{CA: key},
+			want: errInvalidConfig("tls ca, no certificate found"),
+		},
+		{
+			desc: "missing file",
+			tls:  TLSConfig{CAFile: filepath.Join(t.TempDir(), "ca.pem")},
+			want: os.ErrNotExist,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			tlsConfig := tC.tls
+			api := New(ClientConfig{Host: "127.0.0.1", Port: 8883, Protocol: tC.protocol, TLS: &tlsConfig}, false)
+			if err := api.Connect(); !errors.Is(err, tC.want) {
+				t.Errorf("want %s, got %v", tC.want, err)
+			}
+		})
+	}
+}
+
+func TestClientTLSReload(t *testing.T) {
+	old := newTestCA(t)
+	ca := newTestCA(t)
+	host, port := newTLSBroker(t, ca, ca)
+
+	cert, key := old.issue(t, "client", false)
+	certFile, keyFile := writeTemp(t, cert), writeTemp(t, key)
+
+	api := New(ClientConfig{Host: host, Port: port, TLS: &TLSConfig{
+		CA:       ca.pem,
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	}}, false)
+	if err := api.Connect(); err == nil {
+		t.Fatal("want error, got nil")
+	}
+
+	// renew certificate without restart
+	cert, key = ca.issue(t, "client", false)
+	if err := os.WriteFile(certFile, cert, 0o600); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if err := os.WriteFile(keyFile, key, 0o600); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	api.Disconnect()
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue return PEM of certificate & key signed by ca.
+func (ca *testCA) issue(t *testing.T, name string, server bool) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if server {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		tmpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func (ca *testCA) file(t *testing.T) string {
+	return writeTemp(t, ca.pem)
+}
+
+// newTLSBroker serve broker over tls, client certificate signed by clientCA is required.
+func newTLSBroker(t *testing.T, ca *testCA, clientCA *testCA) (string, int) {
+	t.Helper()
+
+	cert, key := ca.issue(t, "broker", true)
+	pair, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(clientCA.cert)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	b := broker.New()
+	go b.Serve(tls.NewListener(ln, &tls.Config{
+		Certificates: []tls.Certificate{pair},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}))
+	t.Cleanup(func() { b.Close() })
+
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port
+}
+
+func writeTemp(t *testing.T, b []byte) string {
+	t.Helper()
+
+	f, err := os.CreateTemp(t.TempDir(), "*.pem")
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(b); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	return f.Name()
+}
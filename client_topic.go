@@ -0,0 +1,198 @@
+package sdk
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TopicScheme is topic layout of VCU messages, each topic is a template with
+// TOPIC_VIN placeholder as a whole level, ex: "stg/VCU/{vin}/RPT".
+// Empty template use the default layout of TOPIC_*.
+type TopicScheme struct {
+	Status   string
+	Report   string
+	Command  string
+	Response string
+}
+
+// PrefixTopics return default topic layout under prefix, ex: "stg" give "stg/VCU/{vin}/RPT".
+func PrefixTopics(prefix string) TopicScheme {
+	ts := defaultTopicScheme()
+	if prefix = strings.Trim(prefix, "/"); prefix == "" {
+		return ts
+	}
+	return TopicScheme{
+		Status:   prefix + "/" + ts.Status,
+		Report:   prefix + "/" + ts.Report,
+		Command:  prefix + "/" + ts.Command,
+		Response: prefix + "/" + ts.Response,
+	}
+}
+
+// Resolve validate templates of ts and fill the empty ones with default layout,
+// as it is done for ClientConfig.Topics.
+func (ts TopicScheme) Resolve() (TopicScheme, error) {
+	def := defaultTopicScheme()
+	if ts.Status == "" {
+		ts.Status = def.Status
+	}
+	if ts.Report == "" {
+		ts.Report = def.Report
+	}
+	if ts.Command == "" {
+		ts.Command = def.Command
+	}
+	if ts.Response == "" {
+		ts.Response = def.Response
+	}
+	if _, err := newTopicScheme(ts); err != nil {
+		return TopicScheme{}, err
+	}
+	return ts, nil
+}
+
+// ParseTopics read comma separated name=template (status, report, command, response)
+// over default layout under prefix, ex: "report=fleet/rpt/{vin},command=fleet/cmd/{vin}".
+// It is used by command line flags, result is resolved.
+func ParseTopics(prefix, templates string) (TopicScheme, error) {
+	ts := PrefixTopics(prefix)
+	if templates == "" {
+		return ts, nil
+	}
+	for _, kv := range strings.Split(templates, ",") {
+		name, tpl, ok := strings.Cut(kv, "=")
+		if !ok {
+			return TopicScheme{}, errInvalidConfig("topic " + kv)
+		}
+		tpl = strings.TrimSpace(tpl)
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "status":
+			ts.Status = tpl
+		case "report":
+			ts.Report = tpl
+		case "command":
+			ts.Command = tpl
+		case "response":
+			ts.Response = tpl
+		default:
+			return TopicScheme{}, errInvalidConfig("topic name " + name)
+		}
+	}
+	return ts.Resolve()
+}
+
+// TopicOf insert vin into topic template, ex: "VCU/{vin}/RPT" give "VCU/354313/RPT".
+func TopicOf(tpl string, vin int) string {
+	return strings.Replace(tpl, TOPIC_VIN, strconv.Itoa(vin), 1)
+}
+
+// VinOf extract vin from topic of template tpl, it is false when topic doesn't match.
+func VinOf(tpl, topic string) (int, bool) {
+	t, err := parseTopicTemplate(tpl)
+	if err != nil {
+		return 0, false
+	}
+	return t.vin(topic)
+}
+
+// defaultTopicScheme convert TOPIC_* wildcard into templates.
+func defaultTopicScheme() TopicScheme {
+	return TopicScheme{
+		Status:   strings.Replace(TOPIC_STATUS, "+", TOPIC_VIN, 1),
+		Report:   strings.Replace(TOPIC_REPORT, "+", TOPIC_VIN, 1),
+		Command:  strings.Replace(TOPIC_COMMAND, "+", TOPIC_VIN, 1),
+		Response: strings.Replace(TOPIC_RESPONSE, "+", TOPIC_VIN, 1),
+	}
+}
+
+// topicScheme is parsed TopicScheme.
+type topicScheme struct {
+	status   topicTemplate
+	report   topicTemplate
+	command  topicTemplate
+	response topicTemplate
+}
+
+// newTopicScheme validate & parse templates, empty ones are defaulted.
+func newTopicScheme(ts TopicScheme) (topicScheme, error) {
+	def := defaultTopicScheme()
+	templates := []*string{&ts.Status, &ts.Report, &ts.Command, &ts.Response}
+	defaults := []string{def.Status, def.Report, def.Command, def.Response}
+
+	seen := map[string]bool{}
+	parsed := make([]topicTemplate, len(templates))
+	for i, tpl := range templates {
+		if *tpl == "" {
+			*tpl = defaults[i]
+		}
+		if seen[*tpl] {
+			return topicScheme{}, errInvalidConfig("duplicate topic " + *tpl)
+		}
+		seen[*tpl] = true
+
+		t, err := parseTopicTemplate(*tpl)
+		if err != nil {
+			return topicScheme{}, err
+		}
+		parsed[i] = t
+	}
+	return topicScheme{
+		status:   parsed[0],
+		report:   parsed[1],
+		command:  parsed[2],
+		response: parsed[3],
+	}, nil
+}
+
+// topicTemplate is topic split around VIN level.
+type topicTemplate struct {
+	prefix string
+	suffix string
+}
+
+func parseTopicTemplate(tpl string) (topicTemplate, error) {
+	if strings.ContainsAny(tpl, "+#") || strings.Count(tpl, TOPIC_VIN) != 1 {
+		return topicTemplate{}, errInvalidConfig("topic " + tpl)
+	}
+
+	i := strings.Index(tpl, TOPIC_VIN)
+	t := topicTemplate{prefix: tpl[:i], suffix: tpl[i+len(TOPIC_VIN):]}
+	if (t.prefix != "" && !strings.HasSuffix(t.prefix, "/")) ||
+		(t.suffix != "" && !strings.HasPrefix(t.suffix, "/")) {
+		return topicTemplate{}, errInvalidConfig("topic " + tpl)
+	}
+	return t, nil
+}
+
+// filter return topic filter of all VINs.
+func (t topicTemplate) filter() string {
+	return t.prefix + "+" + t.suffix
+}
+
+// topic return topic of VIN.
+func (t topicTemplate) topic(vin int) string {
+	return t.prefix + strconv.Itoa(vin) + t.suffix
+}
+
+// topics return topic of each VIN.
+func (t topicTemplate) topics(vins []int) []string {
+	topics := make([]string, len(vins))
+	for i, vin := range vins {
+		topics[i] = t.topic(vin)
+	}
+	return topics
+}
+
+// vin extract VIN from topic, it is false when topic doesn't match template.
+func (t topicTemplate) vin(topic string) (int, bool) {
+	if !strings.HasPrefix(topic, t.prefix) || !strings.HasSuffix(topic, t.suffix) ||
+		len(topic) < len(t.prefix)+len(t.suffix) {
+		return 0, false
+	}
+	level := topic[len(t.prefix) : len(topic)-len(t.suffix)]
+	vin, err := strconv.Atoi(level)
+	if err != nil || strings.Contains(level, "/") {
+		return 0, false
+	}
+	return vin, true
+}
@@ -0,0 +1,169 @@
+package sdk
+
+import "testing"
+
+func TestTopicScheme(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		scheme TopicScheme
+		want   error
+		report string
+		filter string
+	}{
+		{
+			desc:   "default",
+			report: "VCU/354313/RPT",
+			filter: "VCU/+/RPT",
+		},
+		{
+			desc:   "prefix",
+			scheme: PrefixTopics("/stg/"),
+			report: "stg/VCU/354313/RPT",
+			filter: "stg/VCU/+/RPT",
+		},
+		{
+			desc:   "vin at last level",
+			scheme: TopicScheme{Report: "fleet/rpt/{vin}"},
+			report: "fleet/rpt/354313",
+			filter: "fleet/rpt/+",
+		},
+		{
+			desc:   "missing vin",
+			scheme: TopicScheme{Report: "VCU/RPT"},
+			want:   errInvalidConfig("topic VCU/RPT"),
+		},
+		{
+			desc:   "vin is not whole level",
+			scheme: TopicScheme{Report: "VCU/v{vin}/RPT"},
+			want:   errInvalidConfig("topic VCU/v{vin}/RPT"),
+		},
+		{
+			desc:   "wildcard",
+			scheme: TopicScheme{Report: "+/VCU/{vin}/RPT"},
+			want:   errInvalidConfig("topic +/VCU/{vin}/RPT"),
+		},
+		{
+			desc:   "duplicate",
+			scheme: TopicScheme{Report: "VCU/{vin}/STS"},
+			want:   errInvalidConfig("duplicate topic VCU/{vin}/STS"),
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			ts, err := newTopicScheme(tC.scheme)
+			if err != tC.want {
+				t.Fatalf("want %v, got %v", tC.want, err)
+			}
+			resolved, err := tC.scheme.Resolve()
+			if err != tC.want {
+				t.Fatalf("want %v, got %v", tC.want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got := TopicOf(resolved.Report, 354313); got != tC.report {
+				t.Errorf("want %s, got %s", tC.report, got)
+			}
+			if vin, ok := VinOf(resolved.Report, tC.report); !ok || vin != 354313 {
+				t.Errorf("want %d, got %d", 354313, vin)
+			}
+
+			if got := ts.report.topic(354313); got != tC.report {
+				t.Errorf("want %s, got %s", tC.report, got)
+			}
+			if got := ts.report.filter(); got != tC.filter {
+				t.Errorf("want %s, got %s", tC.filter, got)
+			}
+			if vin, ok := ts.report.vin(tC.report); !ok || vin != 354313 {
+				t.Errorf("want %d, got %d", 354313, vin)
+			}
+		})
+	}
+}
+
+func TestTopicTemplateVin(t *testing.T) {
+	tpl, err := parseTopicTemplate("stg/VCU/{vin}/RPT")
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	testCases := []struct {
+		topic string
+		vin   int
+		ok    bool
+	}{
+		{"stg/VCU/354313/RPT", 354313, true},
+		{"VCU/354313/RPT", 0, false},
+		{"stg/VCU/35/43/RPT", 0, false},
+		{"stg/VCU/x/RPT", 0, false},
+		{"stg/VCU/354313/STS", 0, false},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.topic, func(t *testing.T) {
+			vin, ok := tpl.vin(tC.topic)
+			if vin != tC.vin || ok != tC.ok {
+				t.Errorf("want %d %v, got %d %v", tC.vin, tC.ok, vin, ok)
+			}
+		})
+	}
+}
+
+func TestParseTopics(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		prefix    string
+		templates string
+		want      error
+		status    string
+		report    string
+	}{
+		{
+			desc:   "default",
+			status: "VCU/{vin}/STS",
+			report: "VCU/{vin}/RPT",
+		},
+		{
+			desc:   "prefix",
+			prefix: "stg",
+			status: "stg/VCU/{vin}/STS",
+			report: "stg/VCU/{vin}/RPT",
+		},
+		{
+			desc:      "templates over prefix",
+			prefix:    "stg",
+			templates: "report=fleet/rpt/{vin}, Status = fleet/sts/{vin}",
+			status:    "fleet/sts/{vin}",
+			report:    "fleet/rpt/{vin}",
+		},
+		{
+			desc:      "missing name",
+			templates: "fleet/rpt/{vin}",
+			want:      errInvalidConfig("topic fleet/rpt/{vin}"),
+		},
+		{
+			desc:      "unknown name",
+			templates: "log=fleet/log/{vin}",
+			want:      errInvalidConfig("topic name log"),
+		},
+		{
+			desc:      "invalid template",
+			templates: "report=fleet/rpt",
+			want:      errInvalidConfig("topic fleet/rpt"),
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			ts, err := ParseTopics(tC.prefix, tC.templates)
+			if err != tC.want {
+				t.Fatalf("want %v, got %v", tC.want, err)
+			}
+			if err != nil {
+				return
+			}
+			if ts.Status != tC.status || ts.Report != tC.report {
+				t.Errorf("want %s %s, got %s %s", tC.status, tC.report, ts.Status, ts.Report)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package sdk
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// DispatchConfig run listener callbacks on worker pool, so slow callback doesn't block
+// other messages (ex: command response). Messages of a VIN are handled in order by one worker.
+type DispatchConfig struct {
+	// Workers is number of worker goroutines, zero run callbacks on mqtt goroutine.
+	Workers int
+	// QueueSize is buffered messages per worker, default DEFAULT_DISPATCH_QUEUE.
+	QueueSize int
+	// Policy decide what to do when worker queue is full, default is block.
+	// With block, callback must not wait for command response, the response is queued
+	// behind it when the queue is full, so command only end by timeout.
+	Policy QueuePolicy
+}
+
+// DispatchStats is snapshot of dispatch worker pool, it is zero when dispatch is synchronous.
+type DispatchStats struct {
+	Workers int
+	// Queued is messages waiting on all workers, out of Capacity.
+	Queued   int
+	Capacity int
+	// Dropped is count of messages dropped by full queue.
+	Dropped uint64
+}
+
+// DispatchStats return state of dispatch worker pool, see ClientConfig.Dispatch.
+func (s *Sdk) DispatchStats() DispatchStats {
+	return s.client.workers.stats()
+}
+
+// validDispatch check dispatch config.
+func validDispatch(cfg DispatchConfig) error {
+	if cfg.Workers < 0 || cfg.QueueSize < 0 {
+		return errInvalidConfig("dispatch")
+	}
+	if cfg.Policy >= QueuePolicyLimit {
+		return errInvalidConfig("dispatch policy")
+	}
+	return nil
+}
+
+// workerPool run jobs of a VIN on the same worker, in order.
+type workerPool struct {
+	// mu guard queues & done, submit doesn't hold it while waiting on full queue.
+	mu     sync.RWMutex
+	queues []chan func()
+	// done is closed on stop to release blocked submit, queues are closed once sending is zero.
+	done    chan struct{}
+	sending sync.WaitGroup
+	wg      sync.WaitGroup
+	workers int
+	size    int
+	policy  QueuePolicy
+	dropped uint64
+}
+
+// newWorkerPool create pool of cfg, it is nil when dispatch is synchronous.
+func newWorkerPool(cfg DispatchConfig) *workerPool {
+	if cfg.Workers <= 0 {
+		return nil
+	}
+	size := cfg.QueueSize
+	if size == 0 {
+		size = DEFAULT_DISPATCH_QUEUE
+	}
+	return &workerPool{
+		workers: cfg.Workers,
+		size:    size,
+		policy:  cfg.Policy,
+	}
+}
+
+// start run the workers, it is no-op when already started.
+func (p *workerPool) start() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.queues != nil {
+		return
+	}
+	p.done = make(chan struct{})
+	p.queues = make([]chan func(), p.workers)
+	for i := range p.queues {
+		q := make(chan func(), p.size)
+		p.queues[i] = q
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for job := range q {
+				job()
+			}
+		}()
+	}
+}
+
+// stop wait queued jobs to finish and stop the workers, it can be started again.
+// Submit blocked on full queue is released and its job dropped.
+func (p *workerPool) stop() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	queues := p.queues
+	if p.done != nil {
+		close(p.done)
+		p.done = nil
+	}
+	p.queues = nil
+	p.mu.Unlock()
+
+	p.sending.Wait()
+	for _, q := range queues {
+		close(q)
+	}
+	p.wg.Wait()
+}
+
+// submit queue job on worker of vin, it is run directly when pool is not started.
+// It return false when a message is dropped, either job or the oldest queued one.
+func (p *workerPool) submit(vin int, job func()) bool {
+	if p == nil {
+		job()
+		return true
+	}
+	p.mu.RLock()
+	if p.queues == nil {
+		p.mu.RUnlock()
+		job()
+		return true
+	}
+	q, done := p.queues[uint(vin)%uint(len(p.queues))], p.done
+	p.sending.Add(1)
+	p.mu.RUnlock()
+	defer p.sending.Done()
+
+	switch p.policy {
+	case QueuePolicyDropNewest:
+		select {
+		case q <- job:
+			return true
+		default:
+		}
+	case QueuePolicyDropOldest:
+		ok := true
+		for {
+			select {
+			case q <- job:
+				return ok
+			default:
+			}
+			select {
+			case <-q:
+				atomic.AddUint64(&p.dropped, 1)
+				ok = false
+			default:
+			}
+		}
+	default:
+		select {
+		case q <- job:
+			return true
+		case <-done:
+		}
+	}
+	atomic.AddUint64(&p.dropped, 1)
+	return false
+}
+
+func (p *workerPool) stats() DispatchStats {
+	if p == nil {
+		return DispatchStats{}
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	st := DispatchStats{
+		Workers:  p.workers,
+		Capacity: p.workers * p.size,
+		Dropped:  atomic.LoadUint64(&p.dropped),
+	}
+	for _, q := range p.queues {
+		st.Queued += len(q)
+	}
+	return st
+}
+
+// run execute listener job of vin on worker pool, or directly when it is synchronous.
+func (c *client) run(vin int, job func()) {
+	if c == nil {
+		job()
+		return
+	}
+	if !c.workers.submit(vin, job) {
+		c.logger.debug(RPT, "Dropped message, queue is full", "vin", vin)
+	}
+}
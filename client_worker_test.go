@@ -0,0 +1,175 @@
+package sdk
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWorkerPoolOrder(t *testing.T) {
+	p := newWorkerPool(DispatchConfig{Workers: 4})
+	p.start()
+
+	mu := &sync.Mutex{}
+	got := map[int][]int{}
+	for i := 0; i < 100; i++ {
+		i := i
+		for vin := 1; vin <= 8; vin++ {
+			vin := vin
+			p.submit(vin, func() {
+				mu.Lock()
+				defer mu.Unlock()
+				got[vin] = append(got[vin], i)
+			})
+		}
+	}
+	p.stop()
+
+	for vin := 1; vin <= 8; vin++ {
+		if len(got[vin]) != 100 {
+			t.Fatalf("want %d jobs of %d, got %d", 100, vin, len(got[vin]))
+		}
+		for i, v := range got[vin] {
+			if v != i {
+				t.Fatalf("want %d, got %d", i, v)
+			}
+		}
+	}
+	if st := p.stats(); st.Queued != 0 || st.Dropped != 0 {
+		t.Errorf("want empty queue, got %+v", st)
+	}
+}
+
+func TestWorkerPoolFull(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		policy QueuePolicy
+		want   []string
+	}{
+		{
+			desc:   "drop newest",
+			policy: QueuePolicyDropNewest,
+			want:   []string{"busy", "queued"},
+		},
+		{
+			desc:   "drop oldest",
+			policy: QueuePolicyDropOldest,
+			want:   []string{"busy", "full"},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			p := newWorkerPool(DispatchConfig{Workers: 1, QueueSize: 1, Policy: tC.policy})
+			p.start()
+
+			var got []string
+			started, release := make(chan struct{}), make(chan struct{})
+			p.submit(1, func() {
+				close(started)
+				<-release
+				got = append(got, "busy")
+			})
+			<-started
+
+			p.submit(1, func() { got = append(got, "queued") })
+			if st := p.stats(); st.Queued != 1 || st.Capacity != 1 {
+				t.Errorf("want 1/1 queued, got %+v", st)
+			}
+			if p.submit(1, func() { got = append(got, "full") }) {
+				t.Error("want drop reported")
+			}
+			close(release)
+			p.stop()
+
+			if !reflect.DeepEqual(tC.want, got) {
+				t.Errorf("want %v, got %v", tC.want, got)
+			}
+			if st := p.stats(); st.Dropped != 1 {
+				t.Errorf("want 1 dropped, got %d", st.Dropped)
+			}
+		})
+	}
+}
+
+func TestWorkerPoolBlock(t *testing.T) {
+	p := newWorkerPool(DispatchConfig{Workers: 1, QueueSize: 1})
+	p.start()
+	defer p.stop()
+
+	release := make(chan struct{})
+	p.submit(1, func() { <-release })
+	p.submit(1, func() {})
+
+	done := make(chan struct{})
+	go func() {
+		p.submit(1, func() {})
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("want submit blocked on full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("want submit unblocked")
+	}
+}
+
+func TestWorkerPoolBlockStop(t *testing.T) {
+	p := newWorkerPool(DispatchConfig{Workers: 1, QueueSize: 1})
+	p.start()
+
+	release := make(chan struct{})
+	p.submit(1, func() { <-release })
+	p.submit(1, func() {})
+
+	dropped := make(chan bool)
+	go func() {
+		dropped <- !p.submit(1, func() {})
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	stopped := make(chan struct{})
+	go func() {
+		p.stop()
+		close(stopped)
+	}()
+	select {
+	case got := <-dropped:
+		if !got {
+			t.Error("want blocked job dropped on stop")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("want submit released by stop")
+	}
+
+	close(release)
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("want pool stopped")
+	}
+}
+
+func TestWorkerPoolStopped(t *testing.T) {
+	testCases := []struct {
+		desc string
+		pool *workerPool
+	}{
+		{desc: "synchronous", pool: newWorkerPool(DispatchConfig{})},
+		{desc: "not started", pool: newWorkerPool(DispatchConfig{Workers: 1})},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			ran := false
+			if !tC.pool.submit(1, func() { ran = true }) || !ran {
+				t.Error("want job run directly")
+			}
+		})
+	}
+}
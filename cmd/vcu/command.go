@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// commanderType is type returned by sdk.NewCommander, used to lookup command methods.
+var commanderType = reflect.TypeOf((*sdk.Sdk).NewCommander).Out(0)
+
+// enums list valid values of enum arguments.
+var enums = map[reflect.Type][]fmt.Stringer{
+	reflect.TypeOf(sdk.Frame(0)): {
+		sdk.FrameSimple,
+		sdk.FrameFull,
+	},
+	reflect.TypeOf(sdk.BikeState(0)): {
+		sdk.BikeStateUnknown,
+		sdk.BikeStateLost,
+		sdk.BikeStateBackup,
+		sdk.BikeStateNormal,
+		sdk.BikeStateStandby,
+		sdk.BikeStateReady,
+		sdk.BikeStateRun,
+	},
+	reflect.TypeOf(sdk.ModeDrive(0)): {
+		sdk.ModeDriveEconomy,
+		sdk.ModeDriveStandard,
+		sdk.ModeDriveSport,
+	},
+	reflect.TypeOf(sdk.ModeTrip(0)): {
+		sdk.ModeTripOdo,
+		sdk.ModeTripA,
+		sdk.ModeTripB,
+	},
+	reflect.TypeOf(sdk.ModeAvg(0)): {
+		sdk.ModeAvgRange,
+		sdk.ModeAvgEfficiency,
+	},
+}
+
+// command is commander method callable from CLI.
+type command struct {
+	name    string
+	invoker string
+	method  reflect.Method
+}
+
+// listCommands return all registered commands implemented by commander.
+func listCommands() []command {
+	cmds := []command{}
+	for _, invoker := range sdk.Commands() {
+		method, ok := commanderType.MethodByName(invoker)
+		if !ok {
+			continue
+		}
+		cmds = append(cmds, command{
+			name:    toKebab(invoker),
+			invoker: invoker,
+			method:  method,
+		})
+	}
+	return cmds
+}
+
+// findCommand lookup command by its name (hbar-drive) or invoker (HbarDrive).
+func findCommand(name string) (command, error) {
+	for _, c := range listCommands() {
+		if c.name == name || strings.EqualFold(c.invoker, name) {
+			return c, nil
+		}
+	}
+	return command{}, fmt.Errorf("unknown command %q, run \"vcu list\" to show all", name)
+}
+
+// params return method arguments type, without receiver.
+func (c command) params() []reflect.Type {
+	params := []reflect.Type{}
+	for i := 1; i < c.method.Type.NumIn(); i++ {
+		params = append(params, c.method.Type.In(i))
+	}
+	return params
+}
+
+// usage describe command & its arguments.
+func (c command) usage() string {
+	s := []string{c.name}
+	for _, p := range c.params() {
+		s = append(s, "<"+describeType(p)+">")
+	}
+	return strings.Join(s, " ")
+}
+
+// parseArgs convert CLI arguments to method arguments.
+// Slice argument (ex: templates) consume the rest of arguments.
+func (c command) parseArgs(args []string) ([]reflect.Value, error) {
+	params := c.params()
+	values := []reflect.Value{}
+
+	for i, p := range params {
+		if p.Kind() == reflect.Slice && i == len(params)-1 {
+			v := reflect.MakeSlice(p, 0, len(args))
+			for _, arg := range args {
+				item, err := parseValue(p.Elem(), arg)
+				if err != nil {
+					return nil, err
+				}
+				v = reflect.Append(v, item)
+			}
+			return append(values, v), nil
+		}
+
+		if len(args) == 0 {
+			return nil, fmt.Errorf("%s need %d argument(s)", c.name, len(params))
+		}
+		v, err := parseValue(p, args[0])
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		args = args[1:]
+	}
+
+	if len(args) > 0 {
+		return nil, fmt.Errorf("%s need %d argument(s)", c.name, len(params))
+	}
+	return values, nil
+}
+
+// call invoke command on commander, and return its result.
+func (c command) call(cmder interface{}, in []reflect.Value) (interface{}, error) {
+	outs := reflect.ValueOf(cmder).MethodByName(c.invoker).Call(in)
+
+	var res interface{}
+	if len(outs) > 1 {
+		res = outs[0].Interface()
+	}
+	if err, ok := outs[len(outs)-1].Interface().(error); ok && err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// parseValue convert s to value of type t.
+func parseValue(t reflect.Type, s string) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+
+	if values, ok := enums[t]; ok {
+		for _, e := range values {
+			if strings.EqualFold(e.String(), s) {
+				return reflect.ValueOf(e), nil
+			}
+		}
+		return v, fmt.Errorf("invalid %s %q, want one of %s", t.Name(), s, describeType(t))
+	}
+
+	switch t {
+	case reflect.TypeOf(time.Time{}):
+		if s == "now" {
+			return reflect.ValueOf(time.Now()), nil
+		}
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return v, fmt.Errorf("invalid time %q, want now or RFC3339", s)
+		}
+		return reflect.ValueOf(tm), nil
+	case reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return v, fmt.Errorf("invalid duration %q", s)
+		}
+		return reflect.ValueOf(d), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		switch strings.ToLower(s) {
+		case "on", "true", "1", "yes":
+			v.SetBool(true)
+		case "off", "false", "0", "no":
+			v.SetBool(false)
+		default:
+			return v, fmt.Errorf("invalid bool %q, want on or off", s)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, t.Bits())
+		if err != nil {
+			return v, fmt.Errorf("invalid %s %q", t.Kind(), s)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, t.Bits())
+		if err != nil {
+			return v, fmt.Errorf("invalid %s %q", t.Kind(), s)
+		}
+		v.SetUint(n)
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Struct:
+		// struct fields are separated by colon, ex: 50:10
+		parts := strings.Split(s, ":")
+		if len(parts) != t.NumField() {
+			return v, fmt.Errorf("invalid %s %q, want %s", t.Name(), s, describeType(t))
+		}
+		for i, part := range parts {
+			f, err := parseValue(t.Field(i).Type, part)
+			if err != nil {
+				return v, err
+			}
+			v.Field(i).Set(f)
+		}
+	default:
+		return v, fmt.Errorf("unsupported argument type %s", t)
+	}
+	return v, nil
+}
+
+// describeType render argument type for usage.
+func describeType(t reflect.Type) string {
+	if values, ok := enums[t]; ok {
+		names := []string{}
+		for _, e := range values {
+			names = append(names, strings.ToLower(e.String()))
+		}
+		return strings.Join(names, "|")
+	}
+
+	switch t {
+	case reflect.TypeOf(time.Time{}):
+		return "now|rfc3339"
+	case reflect.TypeOf(time.Duration(0)):
+		return "duration"
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "on|off"
+	case reflect.Slice:
+		return describeType(t.Elem()) + "..."
+	case reflect.Struct:
+		fields := []string{}
+		for i := 0; i < t.NumField(); i++ {
+			fields = append(fields, strings.ToLower(t.Field(i).Name))
+		}
+		return strings.Join(fields, ":")
+	}
+	return t.Kind().String()
+}
+
+// toKebab convert invoker to command name, ex: HbarDrive to hbar-drive.
+func toKebab(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
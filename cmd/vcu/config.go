@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// ENV_CONFIG override default config file location.
+const ENV_CONFIG = "VCU_CONFIG"
+
+// fileConfig is broker credentials stored in config file, ex:
+//
+//	{"host": "localhost", "port": 1883, "user": "vcu", "pass": "secret", "protocol": "tcp"}
+//
+// Websocket path & proxy are optional, ex: "protocol": "wss", "path": "/mqtt", "proxy": "http://proxy:3128"
+//
+// TLS is optional, ex: "tls": {"ca": "ca.pem", "cert": "client.pem", "key": "client.key"}
+//
+// MQTT 5 is optional, ex: "mqtt5": true
+//
+// Topic prefix is optional, ex: "topic_prefix": "stg"
+type fileConfig struct {
+	Host     string     `json:"host"`
+	Port     int        `json:"port"`
+	User     string     `json:"user"`
+	Pass     string     `json:"pass"`
+	Protocol string     `json:"protocol"`
+	Path     string     `json:"path"`
+	Proxy    string     `json:"proxy"`
+	TLS      *tlsConfig `json:"tls"`
+	MQTT5    bool       `json:"mqtt5"`
+	Prefix   string     `json:"topic_prefix"`
+}
+
+// tlsConfig is PEM file paths of TLS, see sdk.TLSConfig.
+type tlsConfig struct {
+	CA                 string `json:"ca"`
+	Cert               string `json:"cert"`
+	Key                string `json:"key"`
+	ServerName         string `json:"server_name"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify"`
+}
+
+// options store cmd flags, non-empty broker flags override config file.
+type options struct {
+	config   string
+	host     string
+	port     int
+	user     string
+	pass     string
+	protocol string
+
+	json     bool
+	parallel int
+	timeout  time.Duration
+	logging  bool
+	payload  bool
+}
+
+func (o *options) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.config, "config", defaultConfigPath(), "config file of broker credentials (env "+ENV_CONFIG+")")
+	fs.StringVar(&o.host, "host", "", "broker host, override config")
+	fs.IntVar(&o.port, "port", 0, "broker port, override config")
+	fs.StringVar(&o.user, "user", "", "broker username, override config")
+	fs.StringVar(&o.pass, "pass", "", "broker password, override config")
+	fs.StringVar(&o.protocol, "protocol", "", "broker protocol, override config")
+
+	fs.BoolVar(&o.json, "json", false, "print result as json, one object per VIN")
+	fs.IntVar(&o.parallel, "parallel", 10, "maximum VINs commanded at once")
+	fs.DurationVar(&o.timeout, "timeout", 2*time.Minute, "maximum time to wait all VINs")
+	fs.BoolVar(&o.logging, "v", false, "verbose logging")
+	fs.BoolVar(&o.payload, "payload", false, "log received packets in hex, with -v")
+}
+
+// clientConfig merge config file & flags.
+func (o *options) clientConfig() (sdk.ClientConfig, error) {
+	fc := fileConfig{
+		Host: "localhost",
+		Port: 1883,
+	}
+
+	if o.config != "" {
+		b, err := os.ReadFile(o.config)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(b, &fc); err != nil {
+				return sdk.ClientConfig{}, fmt.Errorf("config %s: %w", o.config, err)
+			}
+		case os.IsNotExist(err) && o.config == defaultConfigPath():
+			// default config is optional
+		default:
+			return sdk.ClientConfig{}, err
+		}
+	}
+
+	if o.host != "" {
+		fc.Host = o.host
+	}
+	if o.port != 0 {
+		fc.Port = o.port
+	}
+	if o.user != "" {
+		fc.User = o.user
+	}
+	if o.pass != "" {
+		fc.Pass = o.pass
+	}
+	if o.protocol != "" {
+		fc.Protocol = o.protocol
+	}
+
+	cc := sdk.ClientConfig{
+		Host:       fc.Host,
+		Port:       fc.Port,
+		User:       fc.User,
+		Pass:       fc.Pass,
+		Protocol:   fc.Protocol,
+		Path:       fc.Path,
+		Proxy:      fc.Proxy,
+		MQTT5:      fc.MQTT5,
+		Topics:     sdk.PrefixTopics(fc.Prefix),
+		LogPayload: o.payload,
+	}
+	if t := fc.TLS; t != nil {
+		cc.TLS = &sdk.TLSConfig{
+			CAFile:             t.CA,
+			CertFile:           t.Cert,
+			KeyFile:            t.Key,
+			ServerName:         t.ServerName,
+			InsecureSkipVerify: t.InsecureSkipVerify,
+		}
+	}
+	return cc, nil
+}
+
+// defaultConfigPath is $VCU_CONFIG, or vcu/config.json in user config dir.
+func defaultConfigPath() string {
+	if path := os.Getenv(ENV_CONFIG); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "vcu", "config.json")
+}
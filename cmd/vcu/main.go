@@ -0,0 +1,110 @@
+// Command vcu is operator CLI to send commands to VCU (Vehicle Control Unit).
+// Broker credentials are read from config file, see -config.
+//
+// Usage:
+//
+//	vcu cmd 354313 hbar-drive sport
+//	vcu cmd 354313 gen-info
+//	vcu cmd -json 354313,354320-354330 report-frame full
+//	vcu list
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+const usage = `Usage:
+  vcu cmd [flags] <vins> <command> [args...]   send command to each VIN
+  vcu list                                     list available commands
+
+VINs are comma separated, range is allowed (354313,354320-354330).
+Run "vcu cmd -h" to show flags.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "cmd":
+		os.Exit(runCmd(os.Args[2:]))
+	case "list":
+		os.Exit(runList())
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown subcommand %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+// runList print all commands with their arguments.
+func runList() int {
+	for _, c := range listCommands() {
+		fmt.Println(c.usage())
+	}
+	return 0
+}
+
+// runCmd send command to VINs, and return exit code.
+func runCmd(args []string) int {
+	fs := flag.NewFlagSet("cmd", flag.ExitOnError)
+	opt := &options{}
+	opt.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: vcu cmd [flags] <vins> <command> [args...]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return 2
+	}
+
+	vins, err := parseVins(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	c, err := findCommand(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	in, err := c.parseArgs(fs.Args()[2:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, "usage:", c.usage())
+		return 2
+	}
+
+	cc, err := opt.clientConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	results, err := send(cc, opt, vins, c, in)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	out := newOutput(os.Stdout, opt.json)
+	failed := false
+	for _, r := range results {
+		out.print(r)
+		if r.err != nil {
+			failed = true
+		}
+	}
+	if failed {
+		return 1
+	}
+	return 0
+}
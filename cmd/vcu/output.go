@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// output print command results as text or json lines.
+type output struct {
+	w    io.Writer
+	json bool
+}
+
+func newOutput(w io.Writer, asJson bool) *output {
+	return &output{w: w, json: asJson}
+}
+
+type jsonResult struct {
+	Vin      int         `json:"vin"`
+	Command  string      `json:"command"`
+	Ok       bool        `json:"ok"`
+	Result   interface{} `json:"result,omitempty"`
+	Error    string      `json:"error,omitempty"`
+	Duration string      `json:"duration"`
+}
+
+func (o *output) print(r result) {
+	if o.json {
+		out := jsonResult{
+			Vin:      r.vin,
+			Command:  r.command,
+			Ok:       r.err == nil,
+			Result:   r.res,
+			Duration: r.duration.Round(time.Millisecond).String(),
+		}
+		if r.err != nil {
+			out.Error = r.err.Error()
+		}
+		json.NewEncoder(o.w).Encode(out)
+		return
+	}
+
+	status := "OK"
+	if r.err != nil {
+		status = "ERROR " + r.err.Error()
+	} else if r.res != nil {
+		status = fmt.Sprint("OK ", r.res)
+	}
+	fmt.Fprintf(o.w, "%d\t%s\t%s\n", r.vin, r.command, status)
+}
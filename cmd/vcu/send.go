@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// result is command outcome of a VIN.
+type result struct {
+	vin      int
+	command  string
+	res      interface{}
+	err      error
+	duration time.Duration
+}
+
+// send connect to broker, and execute command on all VINs concurrently.
+// Results are ordered by VIN.
+func send(cc sdk.ClientConfig, opt *options, vins []int, c command, in []reflect.Value) ([]result, error) {
+	api := sdk.New(cc, opt.logging)
+	if err := api.Connect(); err != nil {
+		return nil, err
+	}
+	defer api.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), opt.timeout)
+	defer cancel()
+
+	parallel := opt.parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+	sem := make(chan struct{}, parallel)
+	errTimeout := fmt.Errorf("no result after %s", opt.timeout)
+
+	results := make([]result, len(vins))
+	var wg sync.WaitGroup
+	for i, vin := range vins {
+		results[i] = result{vin: vin, command: c.name}
+
+		wg.Add(1)
+		go func(r *result) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				r.err = errTimeout
+				return
+			}
+
+			done := make(chan result, 1)
+			go func(out result) {
+				start := time.Now()
+				out.res, out.err = exec(&api, out.vin, c, in)
+				out.duration = time.Since(start)
+				done <- out
+			}(*r)
+
+			select {
+			case *r = <-done:
+			case <-ctx.Done():
+				// the pending command is abandoned, its result is discarded
+				r.err = errTimeout
+			}
+		}(&results[i])
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// exec run command on single VIN.
+func exec(api *sdk.Sdk, vin int, c command, in []reflect.Value) (interface{}, error) {
+	cmder, err := api.NewCommander(vin)
+	if err != nil {
+		return nil, err
+	}
+	defer cmder.Destroy()
+
+	return c.call(cmder, in)
+}
+
+// parseVins parse comma separated VINs and ranges, ex: 354313,354320-354330.
+func parseVins(s string) ([]int, error) {
+	set := map[int]bool{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(part, "-", 2)
+		min, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid VIN %q", part)
+		}
+		max := min
+		if len(bounds) == 2 {
+			if max, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, fmt.Errorf("invalid VIN range %q", part)
+			}
+		}
+		for _, vin := range sdk.VinRange(min, max) {
+			set[vin] = true
+		}
+	}
+	if len(set) == 0 {
+		return nil, fmt.Errorf("no VIN given")
+	}
+
+	vins := make([]int, 0, len(set))
+	for vin := range set {
+		vins = append(vins, vin)
+	}
+	sort.Ints(vins)
+	return vins, nil
+}
@@ -0,0 +1,230 @@
+// Command vcudecode decode raw VCU packets offline.
+// Payload is hex or base64 (auto-detected), taken from arguments, stdin
+// (one payload per line, debug log lines "... topic=VCU/1/RPT payload=5440..."
+// and the older "VCU/1/RPT => 5440..." are accepted)
+// or a capture file written by sdk.Recorder.
+//
+// Usage:
+//
+//	go run ./cmd/vcudecode 5440B701000A680500...
+//	grep RPT sdk.log | go run ./cmd/vcudecode -format json
+//	go run ./cmd/vcudecode -capture field.rec -format hex
+//	grep STS sdk.log | go run ./cmd/vcudecode -topics status=fleet/sts/{vin}
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// config store decoder options
+type config struct {
+	format  string
+	input   string
+	capture string
+	topics  sdk.TopicScheme
+}
+
+// item is a payload to decode, with its origin when known.
+type item struct {
+	topic   string
+	time    time.Time
+	payload []byte
+	err     error
+}
+
+func main() {
+	cfg := parseFlags()
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	p, err := newPrinter(cfg.format, cfg.topics.Status, out)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var items <-chan item
+	switch {
+	case cfg.capture != "":
+		f, err := os.Open(cfg.capture)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		items = readCapture(f)
+	case flag.NArg() > 0:
+		items = readLines(strings.NewReader(strings.Join(flag.Args(), "\n")), cfg.input)
+	default:
+		items = readLines(os.Stdin, cfg.input)
+	}
+
+	failed := false
+	for it := range items {
+		if err := p.print(it); err != nil {
+			failed = true
+		}
+	}
+	if err := p.close(); err != nil {
+		log.Fatal(err)
+	}
+	if failed {
+		out.Flush()
+		os.Exit(1)
+	}
+}
+
+// parseFlags read config from command line flags
+func parseFlags() *config {
+	cfg := &config{}
+	var prefix, topics string
+
+	flag.StringVar(&cfg.format, "format", "text", "output format (text, json, hex)")
+	flag.StringVar(&cfg.input, "input", "auto", "payload encoding (auto, hex, base64)")
+	flag.StringVar(&cfg.capture, "capture", "", "capture file written by sdk.Recorder")
+	flag.StringVar(&prefix, "topic-prefix", "", "prefix of default topics, ex: stg")
+	flag.StringVar(&topics, "topics", "", "topic templates with {vin} level, ex: status=fleet/sts/{vin}")
+	flag.Parse()
+
+	switch cfg.input {
+	case "auto", "hex", "base64":
+	default:
+		log.Fatalf("unknown input %q", cfg.input)
+	}
+
+	ts, err := sdk.ParseTopics(prefix, topics)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cfg.topics = ts
+	return cfg
+}
+
+// readLines parse one payload per line.
+func readLines(r io.Reader, input string) <-chan item {
+	items := make(chan item)
+	go func() {
+		defer close(items)
+
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+			it := item{}
+			it.topic, line = splitLogLine(line)
+			it.payload, it.err = parsePayload(line, input)
+			items <- it
+		}
+		if err := scanner.Err(); err != nil {
+			items <- item{err: err}
+		}
+	}()
+	return items
+}
+
+// readCapture read records from capture file.
+func readCapture(r io.Reader) <-chan item {
+	items := make(chan item)
+	go func() {
+		defer close(items)
+
+		rr := sdk.NewRecordReader(r)
+		for {
+			rec, err := rr.Next()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				items <- item{err: err}
+				return
+			}
+			items <- item{
+				topic:   rec.Topic,
+				time:    rec.Time,
+				payload: rec.Payload,
+			}
+		}
+	}()
+	return items
+}
+
+// splitLogLine extract topic & payload from debug log line,
+// "... topic=<topic> payload=<hex>" or the older "<topic> => <hex>".
+func splitLogLine(line string) (string, string) {
+	topic, payload, found := "", "", false
+	for _, field := range strings.Fields(line) {
+		if v := strings.TrimPrefix(field, "topic="); v != field {
+			topic = unquote(v)
+		}
+		if v := strings.TrimPrefix(field, "payload="); v != field {
+			payload, found = unquote(v), true
+		}
+	}
+	if found {
+		return topic, payload
+	}
+
+	i := strings.Index(line, "=>")
+	if i < 0 {
+		return "", line
+	}
+	fields := strings.Fields(line[:i])
+	if len(fields) > 0 {
+		topic = fields[len(fields)-1]
+	}
+	return topic, strings.TrimSpace(line[i+2:])
+}
+
+// unquote remove quotes of log value, which is quoted when it has space.
+func unquote(v string) string {
+	if s, err := strconv.Unquote(v); err == nil {
+		return s
+	}
+	return v
+}
+
+// parsePayload decode hex or base64 payload.
+func parsePayload(s string, input string) ([]byte, error) {
+	s = strings.Join(strings.Fields(s), "")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+
+	if input == "hex" || (input == "auto" && isHex(s)) {
+		return hex.DecodeString(s)
+	}
+
+	s = strings.TrimRight(s, "=")
+	b, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		b, err = base64.RawURLEncoding.DecodeString(s)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("payload is neither hex nor base64: %q", s)
+	}
+	return b, nil
+}
+
+func isHex(s string) bool {
+	if len(s) == 0 || len(s)%2 != 0 {
+		return false
+	}
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestSplitLogLine(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		line    string
+		topic   string
+		payload string
+	}{
+		{
+			desc:    "key values",
+			line:    "SDK 10:04:05 DEBUG Received invoker=report topic=VCU/354313/STS payload=31",
+			topic:   "VCU/354313/STS",
+			payload: "31",
+		},
+		{
+			desc:    "arrow",
+			line:    "SDK 10:04:05 VCU/354313/RPT => 5440",
+			topic:   "VCU/354313/RPT",
+			payload: "5440",
+		},
+		{
+			desc:    "raw payload",
+			line:    "5440",
+			payload: "5440",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			topic, payload := splitLogLine(tC.line)
+			if topic != tC.topic {
+				t.Errorf("want %s, got %s", tC.topic, topic)
+			}
+			if payload != tC.payload {
+				t.Errorf("want %s, got %s", tC.payload, payload)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// printer render decoded item, and return its decode error.
+type printer interface {
+	print(it item) error
+	close() error
+}
+
+// newPrinter create printer of format, status is resolved status topic template.
+func newPrinter(format, status string, w io.Writer) (printer, error) {
+	switch format {
+	case "text":
+		return &textPrinter{w: w, status: status}, nil
+	case "hex":
+		return &textPrinter{w: w, status: status, dump: true}, nil
+	case "json":
+		return &jsonPrinter{enc: json.NewEncoder(w), status: status}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// decoded is inspection result of an item.
+type decoded struct {
+	status  string
+	in      *sdk.Inspection
+	err     error
+	summary string
+}
+
+func decode(it item, status string) decoded {
+	if it.err != nil {
+		return decoded{err: it.err, summary: "INVALID"}
+	}
+
+	// status payload has no prefix
+	if _, ok := sdk.VinOf(status, it.topic); ok {
+		online := "OFFLINE"
+		if string(it.payload) == "1" {
+			online = "ONLINE"
+		}
+		return decoded{status: online, summary: "STATUS " + online}
+	}
+
+	in, err := sdk.Inspect(it.payload)
+	summary := in.Kind.String()
+	for _, f := range in.Fields {
+		switch f.Name {
+		case "Vin":
+			summary += fmt.Sprint(" VIN ", f.Value)
+		case "Version":
+			summary += fmt.Sprint(" v", f.Value)
+		}
+	}
+	if in.Invoker != "" {
+		summary += " " + in.Invoker
+	}
+	summary += fmt.Sprintf(" (%d bytes)", len(it.payload))
+	return decoded{in: in, err: err, summary: summary}
+}
+
+// textPrinter print field per line, with hexdump when dump is set.
+type textPrinter struct {
+	w      io.Writer
+	status string
+	dump   bool
+}
+
+func (p *textPrinter) print(it item) error {
+	d := decode(it, p.status)
+
+	head := []string{}
+	if !it.time.IsZero() {
+		head = append(head, it.time.Format(time.RFC3339Nano))
+	}
+	if it.topic != "" {
+		head = append(head, it.topic)
+	}
+	head = append(head, d.summary)
+	fmt.Fprintln(p.w, strings.Join(head, " "))
+
+	if d.in != nil {
+		for _, f := range d.in.Fields {
+			if p.dump {
+				fmt.Fprintf(p.w, "  %04X  %-24s  %-28s %s\n",
+					f.Offset, hexGroup(f.Raw, 12), f.Name, formatValue(f.Value))
+			} else {
+				fmt.Fprintf(p.w, "  %-28s %s\n", f.Name, formatValue(f.Value))
+			}
+		}
+	}
+	if d.err != nil {
+		fmt.Fprintln(p.w, "  error:", d.err)
+	}
+	fmt.Fprintln(p.w)
+	return d.err
+}
+
+func (p *textPrinter) close() error {
+	return nil
+}
+
+// jsonPrinter print one json object per item.
+type jsonPrinter struct {
+	enc    *json.Encoder
+	status string
+	err    error
+}
+
+type jsonField struct {
+	sdk.Field
+	Hex string `json:"hex"`
+}
+
+type jsonItem struct {
+	Time    *time.Time     `json:"time,omitempty"`
+	Topic   string         `json:"topic,omitempty"`
+	Kind    string         `json:"kind"`
+	Status  string         `json:"status,omitempty"`
+	Invoker string         `json:"invoker,omitempty"`
+	Hex     string         `json:"hex"`
+	Fields  []jsonField    `json:"fields,omitempty"`
+	Data    sdk.PacketData `json:"data,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+func (p *jsonPrinter) print(it item) error {
+	d := decode(it, p.status)
+
+	out := jsonItem{
+		Topic:  it.topic,
+		Status: d.status,
+		Hex:    strings.ToUpper(hex.EncodeToString(it.payload)),
+	}
+	if !it.time.IsZero() {
+		out.Time = &it.time
+	}
+	if d.status != "" {
+		out.Kind = "STATUS"
+	}
+	if d.in != nil {
+		out.Kind = d.in.Kind.String()
+		out.Invoker = d.in.Invoker
+		for _, f := range d.in.Fields {
+			out.Fields = append(out.Fields, jsonField{
+				Field: f,
+				Hex:   strings.ToUpper(hex.EncodeToString(f.Raw)),
+			})
+		}
+		if d.in.Report != nil {
+			out.Data = d.in.Report.Data
+		}
+	}
+	if d.err != nil {
+		if out.Kind == "" {
+			out.Kind = "INVALID"
+		}
+		out.Error = d.err.Error()
+	}
+
+	if err := p.enc.Encode(out); err != nil && p.err == nil {
+		p.err = err
+	}
+	return d.err
+}
+
+func (p *jsonPrinter) close() error {
+	return p.err
+}
+
+// hexGroup format bytes as hex, wrapped after max bytes.
+func hexGroup(b []byte, max int) string {
+	s := strings.ToUpper(hex.EncodeToString(b))
+	if len(b) > max {
+		s = s[:max*2-2] + ".."
+	}
+	return s
+}
+
+// formatValue render field value for text output.
+func formatValue(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "-"
+	case []byte:
+		return fmt.Sprintf("%q", x)
+	case time.Time:
+		return x.Format("2006-01-02 15:04:05 Mon")
+	case float32, float64:
+		return fmt.Sprintf("%.7g", x)
+	}
+	return fmt.Sprint(v)
+}
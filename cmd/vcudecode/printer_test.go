@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestDecodeStatus(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		status  string
+		topic   string
+		payload string
+		summary string
+	}{
+		{
+			desc:    "default status",
+			status:  "VCU/{vin}/STS",
+			topic:   "VCU/354313/STS",
+			payload: "1",
+			summary: "STATUS ONLINE",
+		},
+		{
+			desc:    "custom status",
+			status:  "fleet/sts/{vin}",
+			topic:   "fleet/sts/354313",
+			payload: "0",
+			summary: "STATUS OFFLINE",
+		},
+		{
+			desc:    "default topic on custom status",
+			status:  "fleet/sts/{vin}",
+			topic:   "VCU/354313/STS",
+			payload: "1",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			d := decode(item{topic: tC.topic, payload: []byte(tC.payload)}, tC.status)
+			if tC.summary == "" {
+				if d.status != "" {
+					t.Errorf("want report, got status %s", d.status)
+				}
+				return
+			}
+			if d.summary != tC.summary {
+				t.Errorf("want %s, got %s", tC.summary, d.summary)
+			}
+		})
+	}
+}
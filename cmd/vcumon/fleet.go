@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// sortKey is column used to order rows.
+type sortKey int
+
+const (
+	sortVin sortKey = iota
+	sortOnline
+	sortState
+	sortSoc
+	sortSpeed
+	sortSignal
+	sortFaults
+	sortAge
+	sortLimit
+)
+
+func (k sortKey) String() string {
+	return [...]string{
+		"VIN",
+		"ONLINE",
+		"STATE",
+		"SOC",
+		"SPEED",
+		"SIGNAL",
+		"FAULTS",
+		"AGE",
+	}[k]
+}
+
+// vehicle is last known status & report of a VIN.
+type vehicle struct {
+	vin      int
+	online   bool
+	statusAt time.Time
+	report   *sdk.ReportPacket
+	reportAt time.Time
+}
+
+// row is summary of vehicle shown on table.
+type row struct {
+	vin      int
+	online   bool
+	state    string
+	soc      int
+	speed    int
+	gpsFix   bool
+	signal   int
+	faults   []string
+	reportAt time.Time
+	report   *sdk.ReportPacket
+}
+
+// fleet store vehicles, updated by listener callbacks.
+type fleet struct {
+	mutex    *sync.Mutex
+	vehicles map[int]*vehicle
+}
+
+func newFleet() *fleet {
+	return &fleet{
+		mutex:    &sync.Mutex{},
+		vehicles: map[int]*vehicle{},
+	}
+}
+
+func (f *fleet) get(vin int) *vehicle {
+	v, ok := f.vehicles[vin]
+	if !ok {
+		v = &vehicle{vin: vin}
+		f.vehicles[vin] = v
+	}
+	return v
+}
+
+func (f *fleet) setStatus(vin int, online bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	v := f.get(vin)
+	v.online = online
+	v.statusAt = time.Now()
+}
+
+func (f *fleet) setReport(vin int, rp *sdk.ReportPacket) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	v := f.get(vin)
+	v.report = rp
+	v.reportAt = time.Now()
+	// device only report while it is online
+	v.online = true
+}
+
+// rows return filtered & sorted snapshot of vehicles.
+func (f *fleet) rows(q query) []row {
+	f.mutex.Lock()
+	rows := make([]row, 0, len(f.vehicles))
+	for _, v := range f.vehicles {
+		r := newRow(v)
+		if q.match(r) {
+			rows = append(rows, r)
+		}
+	}
+	f.mutex.Unlock()
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if q.desc {
+			a, b = b, a
+		}
+		if less, equal := compare(a, b, q.sort); !equal {
+			return less
+		}
+		return a.vin < b.vin
+	})
+	return rows
+}
+
+func newRow(v *vehicle) row {
+	r := row{
+		vin:      v.vin,
+		online:   v.online,
+		state:    "-",
+		soc:      -1,
+		speed:    -1,
+		signal:   -1,
+		reportAt: v.reportAt,
+		report:   v.report,
+	}
+
+	rp := v.report
+	if rp == nil {
+		return r
+	}
+	if state, ok := rp.GetValue("Vcu.State").(int8); ok {
+		if state >= int8(sdk.BikeStateUnknown) && state < int8(sdk.BikeStateLimit) {
+			r.state = sdk.BikeState(state).String()
+		} else {
+			r.state = fmt.Sprint(state)
+		}
+	}
+	if soc, ok := rp.GetValue("Bms.SOC").(uint8); ok {
+		r.soc = int(soc)
+	}
+	if speed, ok := rp.GetValue("Mcu.Speed").(uint8); ok {
+		r.speed = int(speed)
+	} else if speed, ok := rp.GetValue("Gps.Speed").(uint8); ok {
+		r.speed = int(speed)
+	}
+	if signal, ok := rp.GetValue("Net.Signal").(uint8); ok {
+		r.signal = int(signal)
+	}
+	r.gpsFix = rp.GpsValidHorizontal()
+
+	for _, f := range rp.BmsGetFaults() {
+		r.faults = append(r.faults, "BMS_"+f.String())
+	}
+	mf := rp.McuGetFaults()
+	for _, f := range mf.Post {
+		r.faults = append(r.faults, "MCU_POST_"+f.String())
+	}
+	for _, f := range mf.Run {
+		r.faults = append(r.faults, "MCU_RUN_"+f.String())
+	}
+	return r
+}
+
+// compare a & b by key, missing value is ordered last.
+func compare(a, b row, key sortKey) (less, equal bool) {
+	var x, y int
+	switch key {
+	case sortOnline:
+		x, y = boolToInt(!a.online), boolToInt(!b.online)
+	case sortState:
+		return a.state < b.state, a.state == b.state
+	case sortSoc:
+		x, y = a.soc, b.soc
+	case sortSpeed:
+		x, y = a.speed, b.speed
+	case sortSignal:
+		x, y = a.signal, b.signal
+	case sortFaults:
+		// most faults first
+		x, y = -len(a.faults), -len(b.faults)
+	case sortAge:
+		// freshest first
+		x, y = -int(a.reportAt.Unix()), -int(b.reportAt.Unix())
+	default:
+		x, y = a.vin, b.vin
+	}
+	return x < y, x == y
+}
+
+// query is filter & order of rows.
+type query struct {
+	text       string
+	onlineOnly bool
+	faultsOnly bool
+	sort       sortKey
+	desc       bool
+}
+
+// match check if row pass filter, text is matched to VIN, state & fault names.
+func (q query) match(r row) bool {
+	if q.onlineOnly && !r.online {
+		return false
+	}
+	if q.faultsOnly && len(r.faults) == 0 {
+		return false
+	}
+	if q.text == "" {
+		return true
+	}
+
+	text := strings.ToUpper(q.text)
+	haystack := append([]string{fmt.Sprint(r.vin), r.state}, r.faults...)
+	for _, s := range haystack {
+		if strings.Contains(s, text) {
+			return true
+		}
+	}
+	return false
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,226 @@
+// Command vcumon is live terminal dashboard of VCU (Vehicle Control Unit) fleet.
+// It shows one row per VIN (online, bike state, SOC, speed, GPS fix, signal,
+// active faults & last report age), and drills into full decoded report.
+//
+// Usage:
+//
+//	go run ./cmd/vcumon -host localhost -port 1883
+//	go run ./cmd/vcumon -vins 354313-354330 -stale 30s
+//	go run ./cmd/vcumon -topic-prefix stg
+//
+// Keys: j/k move, enter detail, s sort, r reverse, o online only,
+// f faults only, / filter (VIN, state or fault name), esc clear, q quit.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// config store monitor options
+type config struct {
+	host     string
+	port     int
+	user     string
+	pass     string
+	protocol string
+	topics   sdk.TopicScheme
+
+	vins    []int
+	refresh time.Duration
+	stale   time.Duration
+}
+
+func main() {
+	cfg := parseFlags()
+
+	api := sdk.New(sdk.ClientConfig{
+		Host:     cfg.host,
+		Port:     cfg.port,
+		User:     cfg.user,
+		Pass:     cfg.pass,
+		Protocol: cfg.protocol,
+		Topics:   cfg.topics,
+	}, false)
+	if err := api.Connect(); err != nil {
+		log.Fatal(err)
+	}
+	defer api.Disconnect()
+
+	f := newFleet()
+	listener := sdk.Listener{
+		StatusFunc: f.setStatus,
+		ReportFunc: f.setReport,
+	}
+	if _, err := api.AddListener(listener, cfg.vins...); err != nil {
+		log.Fatal(err)
+	}
+
+	fd := int(os.Stdin.Fd())
+	restore, err := makeRaw(fd)
+	if err == nil {
+		defer restore()
+	}
+	keys := readKeys(os.Stdin, err == nil)
+
+	out := bufio.NewWriter(os.Stdout)
+	// alternate screen & hidden cursor
+	out.WriteString("\x1b[?1049h\x1b[?25l")
+	defer func() {
+		out.WriteString("\x1b[?25h\x1b[?1049l")
+		out.Flush()
+	}()
+
+	title := fmt.Sprintf("VCU monitor %s:%d", cfg.host, cfg.port)
+	u := newUi(f, title, cfg.stale)
+
+	stopChan := make(chan os.Signal, 1)
+	signal.Notify(stopChan, os.Interrupt, syscall.SIGTERM)
+	ticker := time.NewTicker(cfg.refresh)
+	defer ticker.Stop()
+
+	for {
+		u.rows, u.cols = termSize(int(os.Stdout.Fd()))
+		u.render(out)
+		out.Flush()
+
+		select {
+		case key, ok := <-keys:
+			if !ok || !u.handle(key) {
+				return
+			}
+		case <-ticker.C:
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// parseFlags read config from command line flags
+func parseFlags() *config {
+	cfg := &config{}
+	var vins, prefix, topics string
+
+	flag.StringVar(&cfg.host, "host", "localhost", "broker host")
+	flag.IntVar(&cfg.port, "port", 1883, "broker port")
+	flag.StringVar(&cfg.user, "user", "", "broker username")
+	flag.StringVar(&cfg.pass, "pass", "", "broker password")
+	flag.StringVar(&cfg.protocol, "protocol", "tcp", "broker protocol")
+	flag.StringVar(&prefix, "topic-prefix", "", "prefix of default topics, ex: stg")
+	flag.StringVar(&topics, "topics", "", "topic templates with {vin} level, ex: status=fleet/sts/{vin},report=fleet/rpt/{vin}")
+
+	flag.StringVar(&vins, "vins", "", "watched VINs, comma separated or range (354313-354330), empty for all")
+	flag.DurationVar(&cfg.refresh, "refresh", time.Second, "screen refresh interval")
+	flag.DurationVar(&cfg.stale, "stale", time.Minute, "highlight online vehicle without report for this long")
+	flag.Parse()
+
+	for _, part := range strings.Split(vins, ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		min, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			log.Fatalf("invalid VIN %q", part)
+		}
+		max := min
+		if len(bounds) == 2 {
+			if max, err = strconv.Atoi(bounds[1]); err != nil {
+				log.Fatalf("invalid VIN range %q", part)
+			}
+		}
+		cfg.vins = append(cfg.vins, sdk.VinRange(min, max)...)
+	}
+	if cfg.refresh <= 0 {
+		log.Fatal("refresh should be positive")
+	}
+
+	ts, err := sdk.ParseTopics(prefix, topics)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cfg.topics = ts
+	return cfg
+}
+
+// readKeys decode key presses from r. On non raw terminal,
+// keys are read per line and enter is sent at the end of line.
+func readKeys(r *os.File, raw bool) <-chan string {
+	keys := make(chan string)
+	go func() {
+		defer close(keys)
+
+		buf := make([]byte, 32)
+		for {
+			n, err := r.Read(buf)
+			if err != nil {
+				return
+			}
+			for _, key := range parseKeys(buf[:n], raw) {
+				keys <- key
+			}
+		}
+	}()
+	return keys
+}
+
+// escape sequences of special keys
+var escapeKeys = map[string]string{
+	"\x1b[A":  KEY_UP,
+	"\x1b[B":  KEY_DOWN,
+	"\x1bOA":  KEY_UP,
+	"\x1bOB":  KEY_DOWN,
+	"\x1b[5~": KEY_PAGE_UP,
+	"\x1b[6~": KEY_PAGE_DOWN,
+}
+
+func parseKeys(b []byte, raw bool) []string {
+	keys := []string{}
+	s := string(b)
+	for len(s) > 0 {
+		if s[0] == 0x1b {
+			matched := false
+			for seq, key := range escapeKeys {
+				if strings.HasPrefix(s, seq) {
+					keys = append(keys, key)
+					s = s[len(seq):]
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				keys = append(keys, KEY_ESC)
+				s = s[1:]
+			}
+			continue
+		}
+
+		switch s[0] {
+		case '\r', '\n':
+			keys = append(keys, KEY_ENTER)
+		case 0x7f, 0x08:
+			keys = append(keys, KEY_BACKSPACE)
+		case 0x03:
+			keys = append(keys, KEY_QUIT)
+		default:
+			keys = append(keys, s[:1])
+		}
+		s = s[1:]
+	}
+
+	// on line mode, empty line is enter, otherwise trailing newline just submit the line
+	if !raw && len(keys) > 1 && keys[len(keys)-1] == KEY_ENTER && keys[0] != "/" {
+		keys = keys[:len(keys)-1]
+	}
+	return keys
+}
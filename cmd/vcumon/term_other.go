@@ -0,0 +1,15 @@
+//go:build !linux
+
+package main
+
+import "errors"
+
+// makeRaw is not supported, keys are read per line (followed by enter).
+func makeRaw(fd int) (func(), error) {
+	return nil, errors.New("raw terminal is not supported")
+}
+
+// termSize return default terminal rows & columns.
+func termSize(fd int) (int, int) {
+	return DEFAULT_ROWS, DEFAULT_COLS
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+const (
+	DEFAULT_ROWS = 24
+	DEFAULT_COLS = 120
+
+	KEY_UP        = "up"
+	KEY_DOWN      = "down"
+	KEY_PAGE_UP   = "pgup"
+	KEY_PAGE_DOWN = "pgdown"
+	KEY_ENTER     = "enter"
+	KEY_ESC       = "esc"
+	KEY_BACKSPACE = "backspace"
+	KEY_QUIT      = "ctrl+c"
+)
+
+// ANSI escape sequences
+const (
+	ansiClear   = "\x1b[H\x1b[2J"
+	ansiReverse = "\x1b[7m"
+	ansiBold    = "\x1b[1m"
+	ansiRed     = "\x1b[31m"
+	ansiYellow  = "\x1b[33m"
+	ansiGreen   = "\x1b[32m"
+	ansiDim     = "\x1b[2m"
+	ansiReset   = "\x1b[0m"
+)
+
+// ui is dashboard state, table of vehicles or detail of selected vehicle.
+type ui struct {
+	fleet *fleet
+	title string
+	stale time.Duration
+
+	query     query
+	selected  int
+	detail    int
+	scroll    int
+	filtering bool
+	input     string
+
+	rows, cols int
+	visible    []row
+}
+
+func newUi(f *fleet, title string, stale time.Duration) *ui {
+	return &ui{
+		fleet: f,
+		title: title,
+		stale: stale,
+		rows:  DEFAULT_ROWS,
+		cols:  DEFAULT_COLS,
+	}
+}
+
+// handle apply key to state, it returns false to quit.
+func (u *ui) handle(key string) bool {
+	if key == KEY_QUIT {
+		return false
+	}
+
+	if u.filtering {
+		switch key {
+		case KEY_ENTER:
+			u.query.text = u.input
+			u.filtering = false
+		case KEY_ESC:
+			u.filtering = false
+		case KEY_BACKSPACE:
+			if len(u.input) > 0 {
+				_, size := utf8.DecodeLastRuneInString(u.input)
+				u.input = u.input[:len(u.input)-size]
+			}
+		default:
+			if utf8.RuneCountInString(key) == 1 {
+				u.input += key
+			}
+		}
+		u.selected = 0
+		return true
+	}
+
+	if u.detail != 0 {
+		switch key {
+		case "q", KEY_ESC, KEY_BACKSPACE, "b":
+			u.detail = 0
+			u.scroll = 0
+		case KEY_UP, "k":
+			u.scroll--
+		case KEY_DOWN, "j":
+			u.scroll++
+		case KEY_PAGE_UP:
+			u.scroll -= u.pageSize()
+		case KEY_PAGE_DOWN, " ":
+			u.scroll += u.pageSize()
+		}
+		if u.scroll < 0 {
+			u.scroll = 0
+		}
+		return true
+	}
+
+	switch key {
+	case "q":
+		return false
+	case KEY_UP, "k":
+		u.selected--
+	case KEY_DOWN, "j":
+		u.selected++
+	case KEY_PAGE_UP:
+		u.selected -= u.pageSize()
+	case KEY_PAGE_DOWN, " ":
+		u.selected += u.pageSize()
+	case "s":
+		u.query.sort = (u.query.sort + 1) % sortLimit
+	case "S":
+		u.query.sort = (u.query.sort + sortLimit - 1) % sortLimit
+	case "r":
+		u.query.desc = !u.query.desc
+	case "o":
+		u.query.onlineOnly = !u.query.onlineOnly
+	case "f":
+		u.query.faultsOnly = !u.query.faultsOnly
+	case "/":
+		u.filtering = true
+		u.input = u.query.text
+	case KEY_ESC:
+		u.query = query{sort: u.query.sort, desc: u.query.desc}
+	case KEY_ENTER:
+		if u.selected >= 0 && u.selected < len(u.visible) {
+			u.detail = u.visible[u.selected].vin
+			u.scroll = 0
+		}
+	}
+	return true
+}
+
+// render draw current screen to w.
+func (u *ui) render(w io.Writer) {
+	var buf bytes.Buffer
+	buf.WriteString(ansiClear)
+
+	if u.detail != 0 {
+		u.renderDetail(&buf)
+	} else {
+		u.renderTable(&buf)
+	}
+
+	// raw terminal need explicit carriage return
+	w.Write(bytes.ReplaceAll(buf.Bytes(), []byte("\n"), []byte("\r\n")))
+}
+
+func (u *ui) renderTable(w *bytes.Buffer) {
+	u.visible = u.fleet.rows(u.query)
+	if u.selected >= len(u.visible) {
+		u.selected = len(u.visible) - 1
+	}
+	if u.selected < 0 {
+		u.selected = 0
+	}
+
+	online := 0
+	for _, r := range u.visible {
+		if r.online {
+			online++
+		}
+	}
+	order := "asc"
+	if u.query.desc {
+		order = "desc"
+	}
+	fmt.Fprintf(w, "%s%s%s  %d vehicle(s), %d online  sort %s %s", ansiBold, u.title, ansiReset,
+		len(u.visible), online, u.query.sort, order)
+	if u.query.onlineOnly {
+		w.WriteString("  [online]")
+	}
+	if u.query.faultsOnly {
+		w.WriteString("  [faults]")
+	}
+	if u.query.text != "" {
+		fmt.Fprintf(w, "  [filter %q]", u.query.text)
+	}
+	w.WriteString("\n\n")
+
+	header := fmt.Sprintf("%-8s %-7s %-8s %4s %5s %-4s %6s %6s  %s",
+		"VIN", "ONLINE", "STATE", "SOC", "SPEED", "GPS", "SIGNAL", "AGE", "FAULTS")
+	fmt.Fprintf(w, "%s%s%s\n", ansiBold, u.clip(header), ansiReset)
+
+	page := u.pageSize()
+	start := 0
+	if u.selected >= page {
+		start = u.selected - page + 1
+	}
+	for i := start; i < len(u.visible) && i < start+page; i++ {
+		line, color := u.formatRow(u.visible[i])
+		line = u.clip(line)
+		if i == u.selected {
+			fmt.Fprintf(w, "%s%s%s\n", ansiReverse, line, ansiReset)
+		} else {
+			fmt.Fprintf(w, "%s%s%s\n", color, line, ansiReset)
+		}
+	}
+	for i := len(u.visible) - start; i < page; i++ {
+		w.WriteString("\n")
+	}
+
+	w.WriteString("\n")
+	if u.filtering {
+		fmt.Fprintf(w, "filter: %s_", u.input)
+		return
+	}
+	fmt.Fprint(w, ansiDim+u.clip("j/k move  enter detail  s/S sort  r reverse  o online  f faults  / filter  esc clear  q quit")+ansiReset)
+}
+
+func (u *ui) formatRow(r row) (string, string) {
+	online, color := "no", ansiDim
+	if r.online {
+		online, color = "yes", ""
+	}
+
+	age := "-"
+	if !r.reportAt.IsZero() {
+		d := time.Since(r.reportAt)
+		age = d.Round(time.Second).String()
+		if r.online && d > u.stale {
+			color = ansiYellow
+		}
+	}
+
+	gps := "-"
+	if r.report != nil {
+		gps = "no"
+		if r.gpsFix {
+			gps = "fix"
+		}
+	}
+
+	faults := strings.Join(r.faults, ",")
+	if len(r.faults) > 0 {
+		color = ansiRed
+	} else if r.online && color == "" {
+		color = ansiGreen
+	}
+
+	line := fmt.Sprintf("%-8d %-7s %-8s %4s %5s %-4s %6s %6s  %s",
+		r.vin, online, r.state, optional(r.soc, "%"), optional(r.speed, ""), gps,
+		optional(r.signal, "%"), age, faults)
+	return line, color
+}
+
+func (u *ui) renderDetail(w *bytes.Buffer) {
+	u.fleet.mutex.Lock()
+	v, ok := u.fleet.vehicles[u.detail]
+	var snapshot vehicle
+	if ok {
+		snapshot = *v
+	}
+	u.fleet.mutex.Unlock()
+
+	status := "OFFLINE"
+	if snapshot.online {
+		status = "ONLINE"
+	}
+	fmt.Fprintf(w, "%sVIN %d%s  %s", ansiBold, u.detail, ansiReset, status)
+	if !snapshot.reportAt.IsZero() {
+		fmt.Fprintf(w, "  report %s ago", time.Since(snapshot.reportAt).Round(time.Second))
+	}
+	w.WriteString("\n\n")
+
+	lines := []string{"no report received yet"}
+	if snapshot.report != nil {
+		text := snapshot.report.String()
+		if bikeErr := snapshot.report.GetBikeError(); bikeErr != sdk.BIKE_NOERROR {
+			text = "Error: " + bikeErr.Error() + "\n" + text
+		}
+		lines = strings.Split(strings.TrimRight(text, "\n"), "\n")
+	}
+
+	page := u.pageSize()
+	if max := len(lines) - page; u.scroll > max {
+		u.scroll = max
+	}
+	if u.scroll < 0 {
+		u.scroll = 0
+	}
+	for i := u.scroll; i < len(lines) && i < u.scroll+page; i++ {
+		w.WriteString(u.clip(lines[i]) + "\n")
+	}
+	for i := len(lines) - u.scroll; i < page; i++ {
+		w.WriteString("\n")
+	}
+
+	fmt.Fprintf(w, "\n%sj/k scroll  space page  esc back  [%d/%d]%s",
+		ansiDim, u.scroll+1, len(lines), ansiReset)
+}
+
+// pageSize is number of rows available for table or detail.
+func (u *ui) pageSize() int {
+	// title, blank, header, blank & help line
+	if n := u.rows - 5; n > 0 {
+		return n
+	}
+	return 1
+}
+
+// clip cut line to terminal width.
+func (u *ui) clip(s string) string {
+	if utf8.RuneCountInString(s) <= u.cols {
+		return s
+	}
+	return string([]rune(s)[:u.cols])
+}
+
+func optional(v int, unit string) string {
+	if v < 0 {
+		return "-"
+	}
+	return fmt.Sprint(v, unit)
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// handler execute command on device, returning response code & message.
+type handler func(d *device, msg []byte) (sdk.ResCode, []byte)
+
+// handlers store command handler by invoker, it covers all sdk.Commands().
+var handlers = map[string]handler{
+	"GenInfo": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		return sdk.ResCodeOk, []byte(fmt.Sprintf("VCU v.664, GEN - 2021, VIN %d (simulated)", d.vin))
+	},
+	"GenLed": setBool(func(d *device, on bool) {}),
+	"GenRtc": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) < 6 {
+			return sdk.ResCodeInvalid, nil
+		}
+		rtc := time.Date(2000+int(msg[0]), time.Month(msg[1]), int(msg[2]),
+			int(msg[3]), int(msg[4]), int(msg[5]), 0, time.UTC)
+		d.clockOffset = time.Until(rtc)
+		return sdk.ResCodeOk, nil
+	},
+	"GenBikeState": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) != 1 {
+			return sdk.ResCodeInvalid, nil
+		}
+		state := sdk.BikeState(int8(msg[0]))
+		if state < sdk.BikeStateNormal || state > sdk.BikeStateRun {
+			return sdk.ResCodeError, []byte(fmt.Sprintf("State should = {%d}", sdk.BikeStateStandby))
+		}
+		d.state = state
+		return sdk.ResCodeOk, nil
+	},
+	"GenLockDown": setBool(func(d *device, on bool) { d.lockDown = on }),
+	"GenCanDebug": setBool(func(d *device, on bool) { d.canDebug = on }),
+	"ReportFlush": ok,
+	"ReportBlock": setBool(func(d *device, on bool) { d.blocked = on }),
+	"ReportInterval": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) != 2 {
+			return sdk.ResCodeInvalid, nil
+		}
+		d.interval = time.Duration(binary.LittleEndian.Uint16(msg)) * time.Second
+		return sdk.ResCodeOk, nil
+	},
+	"ReportFrame": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) != 1 || sdk.Frame(msg[0]) < sdk.FrameSimple || sdk.Frame(msg[0]) >= sdk.FrameLimit {
+			return sdk.ResCodeInvalid, nil
+		}
+		d.frame = sdk.Frame(msg[0])
+		return sdk.ResCodeOk, nil
+	},
+	"AudioBeep": ok,
+	"FingerFetch": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		ids := ""
+		for _, id := range d.fingers {
+			ids += strconv.Itoa(id)
+		}
+		return sdk.ResCodeOk, []byte(ids)
+	},
+	"FingerAdd": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(d.fingers) >= sdk.DRIVER_ID_MAX {
+			return sdk.ResCodeError, []byte("Fingerprint is full")
+		}
+		id := len(d.fingers) + 1
+		d.fingers = append(d.fingers, id)
+		return sdk.ResCodeOk, []byte(strconv.Itoa(id))
+	},
+	"FingerDel": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(d.fingers) > 0 {
+			d.fingers = d.fingers[:len(d.fingers)-1]
+		}
+		return sdk.ResCodeOk, nil
+	},
+	"FingerRst": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		d.fingers = nil
+		return sdk.ResCodeOk, nil
+	},
+	"RemotePairing": ok,
+	"RemoteSeat":    ok,
+	"RemoteAlarm":   ok,
+	"FotaRestart": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		d.bootAt = time.Now()
+		return sdk.ResCodeOk, nil
+	},
+	"FotaVcu": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		return sdk.ResCodeOk, []byte("VCU upgraded v.664 -> v.665")
+	},
+	"FotaHmi": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		return sdk.ResCodeOk, []byte("HMI upgraded v.123 -> v.124")
+	},
+	"NetSendUssd": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		ussd := string(msg)
+		if !strings.HasPrefix(ussd, "*") || !strings.HasSuffix(ussd, "#") {
+			return sdk.ResCodeInvalid, nil
+		}
+		return sdk.ResCodeOk, []byte("Simulated USSD reply for " + ussd)
+	},
+	"NetReadSms": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		return sdk.ResCodeOk, []byte("Simulated SMS inbox is empty")
+	},
+	"HbarTripMeter": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) != 3 || sdk.ModeTrip(msg[0]) >= sdk.ModeTripLimit {
+			return sdk.ResCodeInvalid, nil
+		}
+		km := float64(binary.LittleEndian.Uint16(msg[1:]))
+		switch sdk.ModeTrip(msg[0]) {
+		case sdk.ModeTripOdo:
+			d.odo = km
+		case sdk.ModeTripA:
+			d.tripA = km
+		case sdk.ModeTripB:
+			d.tripB = km
+		}
+		return sdk.ResCodeOk, nil
+	},
+	"HbarDrive": setMode(func(d *device, m uint8) bool {
+		if sdk.ModeDrive(m) >= sdk.ModeDriveLimit {
+			return false
+		}
+		d.driveMode = sdk.ModeDrive(m)
+		return true
+	}),
+	"HbarTrip": setMode(func(d *device, m uint8) bool {
+		if sdk.ModeTrip(m) >= sdk.ModeTripLimit {
+			return false
+		}
+		d.tripMode = sdk.ModeTrip(m)
+		return true
+	}),
+	"HbarAvg": setMode(func(d *device, m uint8) bool {
+		if sdk.ModeAvg(m) >= sdk.ModeAvgLimit {
+			return false
+		}
+		d.avgMode = sdk.ModeAvg(m)
+		return true
+	}),
+	"McuSpeedMax": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) < 1 || msg[0] > sdk.SPEED_KPH_MAX {
+			return sdk.ResCodeInvalid, nil
+		}
+		d.maxSpeed = msg[0]
+		return sdk.ResCodeOk, nil
+	},
+	"McuTemplates": func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) != 2*int(sdk.ModeDriveLimit) {
+			return sdk.ResCodeInvalid, nil
+		}
+		for i := range d.templates {
+			d.templates[i] = sdk.McuTemplate{DisCur: msg[2*i], Torque: msg[2*i+1]}
+		}
+		return sdk.ResCodeOk, nil
+	},
+	"McuSetDriveMode": setMode(func(d *device, m uint8) bool {
+		if sdk.ModeDrive(m) >= sdk.ModeDriveLimit {
+			return false
+		}
+		d.driveMode = sdk.ModeDrive(m)
+		return true
+	}),
+	"ImuAntiThief": setBool(func(d *device, on bool) { d.antiThief = on }),
+}
+
+// ok handle command without side effect
+func ok(d *device, msg []byte) (sdk.ResCode, []byte) {
+	return sdk.ResCodeOk, nil
+}
+
+// setBool create handler for command with boolean message
+func setBool(set func(d *device, on bool)) handler {
+	return func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) != 1 {
+			return sdk.ResCodeInvalid, nil
+		}
+		set(d, msg[0] == 1)
+		return sdk.ResCodeOk, nil
+	}
+}
+
+// setMode create handler for command with single mode byte message
+func setMode(set func(d *device, m uint8) bool) handler {
+	return func(d *device, msg []byte) (sdk.ResCode, []byte) {
+		if len(msg) < 1 || !set(d, msg[0]) {
+			return sdk.ResCodeInvalid, nil
+		}
+		return sdk.ResCodeOk, nil
+	}
+}
+
+// onCommand answer incomming command with ack, then response.
+// Retained (stale) & empty (flush) command are ignored.
+func (d *device) onCommand(client mqtt.Client, msg mqtt.Message) {
+	if msg.Retained() || len(msg.Payload()) == 0 {
+		return
+	}
+
+	cmd, err := sdk.DecodeCommand(msg.Payload())
+	if err != nil {
+		d.logf("invalid command %X: %s", msg.Payload(), err)
+		return
+	}
+	d.logf("received %s", cmd.Name)
+
+	// answer asynchronously, so other message is not blocked
+	go func() {
+		topic := d.topic(d.cfg.topics.Response)
+
+		time.Sleep(d.cfg.latency)
+		d.publish(topic, sdk.QOS_SUB_RESPONSE, false, sdk.EncodeAck())
+
+		code, res := sdk.ResCodeOk, []byte(nil)
+		if exec, ok := handlers[cmd.Invoker]; ok {
+			d.mutex.Lock()
+			code, res = exec(d, cmd.Message)
+			d.mutex.Unlock()
+		}
+		if rand.Float64() < d.cfg.cmdError {
+			code, res = sdk.ResCodeError, []byte("Simulated error")
+		}
+
+		b, err := cmd.EncodeResponse(code, res)
+		if err != nil {
+			d.logf("cant encode response %s", err)
+			return
+		}
+
+		time.Sleep(d.cfg.latency)
+		d.publish(topic, sdk.QOS_SUB_RESPONSE, false, b)
+	}()
+}
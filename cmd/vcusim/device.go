@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// device store state of a simulated VCU
+type device struct {
+	vin    int
+	cfg    *config
+	client mqtt.Client
+	done   chan struct{}
+	mutex  sync.Mutex
+
+	// settings, changed by command
+	interval    time.Duration
+	frame       sdk.Frame
+	blocked     bool
+	lockDown    bool
+	canDebug    bool
+	antiThief   bool
+	state       sdk.BikeState
+	driveMode   sdk.ModeDrive
+	tripMode    sdk.ModeTrip
+	avgMode     sdk.ModeAvg
+	maxSpeed    uint8
+	templates   []sdk.McuTemplate
+	fingers     []int
+	clockOffset time.Duration
+
+	// evolving values
+	bootAt   time.Time
+	soc      float64
+	charging bool
+	speed    float64
+	odo      float64
+	tripA    float64
+	tripB    float64
+	trip     *trip
+
+	// injected faults, cleared after faultTtl reports
+	bmsFaults uint16
+	mcuFaults uint32
+	events    uint16
+	faultTtl  int
+}
+
+// newDevice create simulated device with randomized initial state
+func newDevice(vin int, cfg *config) *device {
+	return &device{
+		vin:       vin,
+		cfg:       cfg,
+		done:      make(chan struct{}),
+		interval:  cfg.interval,
+		frame:     cfg.frame,
+		state:     sdk.BikeStateRun,
+		driveMode: sdk.ModeDriveStandard,
+		maxSpeed:  sdk.SPEED_KPH_MAX,
+		templates: []sdk.McuTemplate{
+			{DisCur: 50, Torque: 10},
+			{DisCur: 50, Torque: 20},
+			{DisCur: 50, Torque: 25},
+		},
+		fingers: []int{1, 2},
+		bootAt:  time.Now(),
+		soc:     float64(50 + rand.Intn(50)),
+		odo:     float64(rand.Intn(10000)),
+		trip:    newTrip(rand.Float64()),
+	}
+}
+
+// topic create device's topic from template of config topics
+func (d *device) topic(tpl string) string {
+	return sdk.TopicOf(tpl, d.vin)
+}
+
+// connect open mqtt connection like the real device,
+// with offline status as last-will.
+func (d *device) connect() error {
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker(fmt.Sprintf("%s://%s:%d", d.cfg.protocol, d.cfg.host, d.cfg.port))
+	opts.SetClientID(fmt.Sprintf("vcusim_%d_%d", d.vin, rand.Int31()))
+	opts.SetUsername(d.cfg.user)
+	opts.SetPassword(d.cfg.pass)
+	opts.SetAutoReconnect(true)
+	opts.SetWill(d.topic(d.cfg.topics.Status), "0", sdk.QOS_SUB_STATUS, true)
+	opts.SetOnConnectHandler(func(client mqtt.Client) {
+		d.logf("connected")
+		d.publish(d.topic(d.cfg.topics.Status), sdk.QOS_SUB_STATUS, true, []byte("1"))
+		client.Subscribe(d.topic(d.cfg.topics.Command), sdk.QOS_SUB_COMMAND, d.onCommand)
+	})
+
+	d.client = mqtt.NewClient(opts)
+	token := d.client.Connect()
+	if token.Wait() && token.Error() != nil {
+		return token.Error()
+	}
+	return nil
+}
+
+// run publish report periodically until device is stopped
+func (d *device) run() {
+	for {
+		d.mutex.Lock()
+		interval := d.interval
+		d.mutex.Unlock()
+
+		select {
+		case <-d.done:
+			return
+		case <-time.After(interval):
+			d.tick(interval)
+		}
+	}
+}
+
+// stop publish offline status and disconnect
+func (d *device) stop() {
+	close(d.done)
+	if d.client == nil || !d.client.IsConnected() {
+		return
+	}
+	d.publish(d.topic(d.cfg.topics.Status), sdk.QOS_SUB_STATUS, true, []byte("0"))
+	d.client.Disconnect(250)
+}
+
+// tick evolve device state, then publish report
+func (d *device) tick(dt time.Duration) {
+	d.mutex.Lock()
+	d.evolve(dt)
+	d.injectFault()
+	blocked := d.blocked
+	rb, err := d.report()
+	var b []byte
+	if err == nil {
+		b, err = rb.Encode()
+	}
+	d.mutex.Unlock()
+
+	if err != nil {
+		log.Println(d.vin, "cant encode report", err)
+		return
+	}
+	if blocked {
+		return
+	}
+	d.publish(d.topic(d.cfg.topics.Report), sdk.QOS_SUB_REPORT, false, b)
+}
+
+// publish send packet, it may be dropped to simulate packet loss
+func (d *device) publish(topic string, qos byte, retained bool, b []byte) {
+	if rand.Float64() < d.cfg.loss {
+		d.logf("dropped %s", topic)
+		return
+	}
+	token := d.client.Publish(topic, qos, retained, b)
+	if token.Wait() && token.Error() != nil {
+		log.Println(d.vin, "cant publish", token.Error())
+		return
+	}
+	d.logf("published %s => %X", topic, b)
+}
+
+// logf print log on verbose mode
+func (d *device) logf(format string, v ...interface{}) {
+	if d.cfg.logging {
+		log.Printf(fmt.Sprint(d.vin, " ", format), v...)
+	}
+}
+
+// now get device's clock, which may be changed by GenRtc command
+func (d *device) now() time.Time {
+	return time.Now().UTC().Add(d.clockOffset)
+}
@@ -0,0 +1,129 @@
+// Command vcusim simulate fleet of VCU (Vehicle Control Unit) devices.
+// Each device connects to the broker, publishes status & periodic reports,
+// and answers incomming commands like the real firmware.
+//
+// Usage:
+//
+//	go run ./cmd/vcusim -host localhost -port 1883 -vin 354313 -count 10
+//	go run ./cmd/vcusim -topic-prefix stg
+//	go run ./cmd/vcusim -topics report=fleet/rpt/{vin},command=fleet/cmd/{vin}
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// config store simulator options
+type config struct {
+	host     string
+	port     int
+	user     string
+	pass     string
+	protocol string
+	topics   sdk.TopicScheme
+
+	vin      int
+	count    int
+	version  int
+	frame    sdk.Frame
+	interval time.Duration
+	latency  time.Duration
+
+	loss     float64
+	fault    float64
+	cmdError float64
+	logging  bool
+}
+
+func main() {
+	cfg := parseFlags()
+	rand.Seed(time.Now().UnixNano())
+
+	devices := make([]*device, cfg.count)
+	for i := range devices {
+		devices[i] = newDevice(cfg.vin+i, cfg)
+	}
+
+	// connect all devices concurrently
+	var wg sync.WaitGroup
+	for _, d := range devices {
+		wg.Add(1)
+		go func(d *device) {
+			defer wg.Done()
+			if err := d.connect(); err != nil {
+				log.Println(d.vin, "cant connect", err)
+				return
+			}
+			d.run()
+		}(d)
+	}
+	log.Printf("Simulating %d device(s) from VIN %d, press ctrl+c to exit", cfg.count, cfg.vin)
+
+	stopChan := make(chan os.Signal, 1)
+	signal.Notify(stopChan, os.Interrupt, syscall.SIGTERM)
+	<-stopChan
+
+	for _, d := range devices {
+		d.stop()
+	}
+	wg.Wait()
+	fmt.Println("Gracefully exit application")
+}
+
+// parseFlags read config from command line flags
+func parseFlags() *config {
+	cfg := &config{}
+	var frame, prefix, topics string
+
+	flag.StringVar(&cfg.host, "host", "localhost", "broker host")
+	flag.IntVar(&cfg.port, "port", 1883, "broker port")
+	flag.StringVar(&cfg.user, "user", "", "broker username")
+	flag.StringVar(&cfg.pass, "pass", "", "broker password")
+	flag.StringVar(&cfg.protocol, "protocol", "tcp", "broker protocol")
+	flag.StringVar(&prefix, "topic-prefix", "", "prefix of default topics, ex: stg")
+	flag.StringVar(&topics, "topics", "", "topic templates with {vin} level, ex: report=fleet/rpt/{vin},command=fleet/cmd/{vin}")
+
+	flag.IntVar(&cfg.vin, "vin", 354313, "first simulated VIN")
+	flag.IntVar(&cfg.count, "count", 1, "number of simulated VINs")
+	flag.IntVar(&cfg.version, "version", 1, "report version")
+	flag.StringVar(&frame, "frame", "full", "report frame (simple, full)")
+	flag.DurationVar(&cfg.interval, "interval", 5*time.Second, "report interval")
+	flag.DurationVar(&cfg.latency, "latency", 300*time.Millisecond, "command ack & response latency")
+
+	flag.Float64Var(&cfg.loss, "loss", 0, "probability of outgoing packet loss (0-1)")
+	flag.Float64Var(&cfg.fault, "fault", 0, "probability of fault injection per report (0-1)")
+	flag.Float64Var(&cfg.cmdError, "cmd-error", 0, "probability of command error response (0-1)")
+	flag.BoolVar(&cfg.logging, "v", false, "verbose logging")
+	flag.Parse()
+
+	switch frame {
+	case "simple":
+		cfg.frame = sdk.FrameSimple
+	case "full":
+		cfg.frame = sdk.FrameFull
+	default:
+		log.Fatalf("unknown frame %q", frame)
+	}
+	if cfg.count < 1 {
+		log.Fatal("count should be at least 1")
+	}
+	if _, ok := sdk.ReportPacketStructures[cfg.version]; !ok {
+		log.Fatalf("unknown report version %d", cfg.version)
+	}
+	ts, err := sdk.ParseTopics(prefix, topics)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cfg.topics = ts
+	return cfg
+}
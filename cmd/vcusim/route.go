@@ -0,0 +1,240 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// waypoint is a point of the simulated route
+type waypoint struct {
+	lat, lng float64
+}
+
+// route is a closed loop around central Jakarta
+var route = []waypoint{
+	{-6.175392, 106.827153}, // Monas
+	{-6.195000, 106.823000}, // Bundaran HI
+	{-6.218300, 106.802000}, // Senayan
+	{-6.244400, 106.799600}, // Blok M
+	{-6.229700, 106.830000}, // Kuningan
+	{-6.208800, 106.845600}, // Manggarai
+	{-6.186500, 106.834100}, // Cikini
+}
+
+// trip store device position along the route
+type trip struct {
+	segment  int
+	fraction float64
+	heading  float64
+}
+
+// newTrip place device at some position (0-1) of the route
+func newTrip(position float64) *trip {
+	p := position * float64(len(route))
+	return &trip{
+		segment:  int(p) % len(route),
+		fraction: p - math.Floor(p),
+	}
+}
+
+// move advance position by km, return current point
+func (t *trip) move(km float64) waypoint {
+	for km > 0 {
+		from, to := route[t.segment], route[(t.segment+1)%len(route)]
+		length := distance(from, to)
+		left := length * (1 - t.fraction)
+		t.heading = bearing(from, to)
+
+		if km < left {
+			t.fraction += km / length
+			break
+		}
+		km -= left
+		t.segment = (t.segment + 1) % len(route)
+		t.fraction = 0
+	}
+	return t.position()
+}
+
+// position interpolate current point on the segment
+func (t *trip) position() waypoint {
+	from, to := route[t.segment], route[(t.segment+1)%len(route)]
+	return waypoint{
+		lat: from.lat + (to.lat-from.lat)*t.fraction,
+		lng: from.lng + (to.lng-from.lng)*t.fraction,
+	}
+}
+
+// distance calculate haversine distance between 2 points in km
+func distance(a, b waypoint) float64 {
+	const earthRadiusKm = 6371
+	dLat := (b.lat - a.lat) * math.Pi / 180
+	dLng := (b.lng - a.lng) * math.Pi / 180
+	h := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(a.lat*math.Pi/180)*math.Cos(b.lat*math.Pi/180)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// bearing calculate heading from a to b in degree
+func bearing(a, b waypoint) float64 {
+	y := math.Sin((b.lng - a.lng) * math.Pi / 180)
+	x := math.Cos(a.lat*math.Pi/180)*math.Tan(b.lat*math.Pi/180) -
+		math.Sin(a.lat*math.Pi/180)*math.Cos((b.lng-a.lng)*math.Pi/180)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// evolve update driving values for elapsed dt:
+// SOC drains while riding and the bike stops to charge when battery is low.
+func (d *device) evolve(dt time.Duration) {
+	hours := dt.Hours()
+
+	switch {
+	case d.charging:
+		d.speed = 0
+		d.soc = math.Min(100, d.soc+30*hours)
+		if d.soc >= 95 {
+			d.charging = false
+		}
+	case d.state != sdk.BikeStateRun || d.lockDown:
+		d.speed = 0
+	default:
+		d.speed += float64(rand.Intn(21) - 10)
+		d.speed = math.Max(10, math.Min(d.speed, float64(d.maxSpeed)))
+		if d.speed > 70 {
+			d.speed = 70
+		}
+
+		km := d.speed * hours
+		d.trip.move(km)
+		d.odo += km
+		d.tripA += km
+		d.tripB += km
+		d.soc = math.Max(0, d.soc-km*0.8)
+		if d.soc < sdk.BMS_LOW_CAPACITY_PERCENT/2 {
+			d.charging = true
+		}
+	}
+
+	if d.faultTtl > 0 {
+		d.faultTtl--
+		if d.faultTtl == 0 {
+			d.bmsFaults, d.mcuFaults, d.events = 0, 0, 0
+		}
+	}
+}
+
+// injectFault randomly raise BMS or MCU fault for some reports
+func (d *device) injectFault() {
+	if d.faultTtl > 0 || rand.Float64() >= d.cfg.fault {
+		return
+	}
+
+	d.faultTtl = 3 + rand.Intn(5)
+	if rand.Intn(2) == 0 {
+		d.bmsFaults = 1 << uint(rand.Intn(int(sdk.BMS_FAULTS_MAX)))
+		d.events = 1 << sdk.VCU_BMS_ERROR
+	} else {
+		d.mcuFaults = 1 << uint(rand.Intn(int(sdk.MCU_RUN_FAULTS_MAX)))
+		d.events = 1 << sdk.VCU_MCU_ERROR
+	}
+	d.logf("injected fault bms=%X mcu=%X", d.bmsFaults, d.mcuFaults)
+}
+
+// report build report packet from current state,
+// error tell the first field which value is rejected by builder.
+func (d *device) report() (*sdk.ReportBuilder, error) {
+	b := sdk.NewReportBuilder(d.cfg.version, d.vin).Frame(d.frame)
+	var err error
+	set := func(key string, value interface{}) {
+		if err != nil || !b.Has(key) {
+			return
+		}
+		if e := b.Set(key, value).Err(); e != nil {
+			err = fmt.Errorf("set %s = %v: %w", key, value, e)
+		}
+	}
+
+	now := d.now()
+	pos := d.trip.position()
+	running := d.speed > 0
+
+	set("Report.SendDatetime", now)
+	set("Report.LogDatetime", now)
+	set("Vcu.State", d.state)
+	set("Vcu.Events", d.events)
+	set("Vcu.Version", 664)
+	set("Vcu.BatVoltage", 4000+rand.Intn(200))
+	set("Vcu.Uptime", time.Since(d.bootAt).Hours())
+	set("Vcu.LockDown", d.lockDown)
+	set("Vcu.CANDebug", d.canDebug)
+	set("Eeprom.Active", true)
+	set("Eeprom.Used", 10+int(d.odo)%50)
+	set("Gps.Active", true)
+	set("Gps.SatInUse", 6+rand.Intn(6))
+	set("Gps.HDOP", 0.8+rand.Float64())
+	set("Gps.VDOP", 1.0+rand.Float64())
+	set("Gps.Speed", int(d.speed))
+	set("Gps.Heading", d.trip.heading)
+	set("Gps.Longitude", pos.lng)
+	set("Gps.Latitude", pos.lat)
+	set("Gps.Altitude", 8+rand.Float64()*4)
+	set("Net.Signal", 40+rand.Intn(60))
+	set("Net.State", sdk.NetStateMqttOn)
+	set("Imu.Active", true)
+	set("Imu.AntiThief", d.antiThief)
+	set("Imu.Total.Temperature", 30+rand.Intn(5))
+	set("Remote.Active", true)
+	set("Remote.Nearby", !running)
+	set("Finger.Active", true)
+	set("Finger.DriverID", 1)
+	set("Audio.Active", true)
+	set("Audio.Volume", 80)
+	set("Hmi.Active", true)
+	set("Hmi.Version", 123)
+	set("Bms.Active", true)
+	set("Bms.Run", running || d.charging)
+	set("Bms.Faults", d.bmsFaults)
+	set("Bms.SOC", int(d.soc))
+	for i := 0; i < sdk.BMS_PACK_MAX; i++ {
+		pack := func(key string) string { return fmt.Sprintf("Bms.Pack.[%d].%s", i, key) }
+		set(pack("ID"), d.vin*10+i)
+		set(pack("Faults"), d.bmsFaults)
+		set(pack("Voltage"), 52+d.soc*0.08)
+		set(pack("Current"), d.speed*0.5)
+		set(pack("SOC"), int(d.soc))
+		set(pack("SOH"), 98)
+		set(pack("Temperature"), 28+int(d.speed/10))
+	}
+	set("Hbar.Mode.Drive", d.driveMode)
+	set("Hbar.Mode.Trip", d.tripMode)
+	set("Hbar.Mode.Avg", d.avgMode)
+	set("Hbar.Trip.Odo", int(d.odo)%(sdk.TRIP_KM_MAX+1))
+	set("Hbar.Trip.A", int(d.tripA)%(sdk.TRIP_KM_MAX+1))
+	set("Hbar.Trip.B", int(d.tripB)%(sdk.TRIP_KM_MAX+1))
+	set("Hbar.Avg.Range", int(d.soc*0.7))
+	set("Hbar.Avg.Efficiency", 35)
+	set("Mcu.Active", true)
+	set("Mcu.Run", running)
+	set("Mcu.DriveMode", d.driveMode)
+	set("Mcu.Speed", int(d.speed))
+	set("Mcu.RPM", int(d.speed*40))
+	set("Mcu.Temperature", 35+int(d.speed/5))
+	set("Mcu.Faults.Run", d.mcuFaults)
+	set("Mcu.DCBus.Current", d.speed*0.5)
+	set("Mcu.DCBus.Voltage", 52+d.soc*0.08)
+	set("Mcu.Template.MaxSpeed", d.maxSpeed)
+	for i, t := range d.templates {
+		mode := fmt.Sprintf("Mcu.Template.DriveMode.[%d].", i)
+		set(mode+"Discur", t.DisCur)
+		set(mode+"Torque", t.Torque)
+	}
+	for _, task := range []string{"Manager", "Network", "Reporter", "Command", "Imu", "Remote", "Finger", "Audio", "Gate", "CanRX", "CanTX"} {
+		set("Task.Stack."+task, 100+rand.Intn(100))
+		set("Task.Wakeup."+task, rand.Intn(10))
+	}
+	return b, err
+}
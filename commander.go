@@ -5,25 +5,27 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"log"
 	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type commander struct {
 	vin     int
-	logger  *log.Logger
+	logger  *logger
 	mutex   *sync.Mutex
 	resChan chan packet
 	client  *client
 	sleeper Sleeper
+	// correlation is correlation data of the last command.
+	correlation atomic.Value
 }
 
 // newCommander create new *commander instance and listen to command & response topic.
-func newCommander(vin int, c *client, s Sleeper, l *log.Logger) (*commander, error) {
+func newCommander(vin int, c *client, s Sleeper, l *logger) (*commander, error) {
 	cmder := &commander{
 		vin:     vin,
 		logger:  l,
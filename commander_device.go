@@ -0,0 +1,71 @@
+package sdk
+
+// CommandPacket is incomming command packet, seen from VCU device side.
+// It is useful to simulate VCU device on integration test.
+type CommandPacket struct {
+	HeaderCommand
+	Name    string
+	Invoker string
+	Message []byte
+}
+
+// DecodeCommand decode command packet published by commander.
+func DecodeCommand(b []byte) (*CommandPacket, error) {
+	cp, err := decodeCommand(b)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd, err := getCmdByCode(int(cp.Header.Code), int(cp.Header.SubCode))
+	if err != nil {
+		return nil, err
+	}
+	return &CommandPacket{
+		HeaderCommand: *cp.Header,
+		Name:          cmd.name,
+		Invoker:       cmd.invoker,
+		Message:       cp.Message,
+	}, nil
+}
+
+// EncodeAck create ack packet, sent by device when command is received.
+func EncodeAck() []byte {
+	return strToBytes(PREFIX_ACK)
+}
+
+// EncodeResponse create response packet for c, sent by device after command is executed.
+func (c *CommandPacket) EncodeResponse(code ResCode, msg []byte) ([]byte, error) {
+	if code >= ResCodeLimit {
+		return nil, errInputOutOfRange("code")
+	}
+	if message(msg).overflow() {
+		return nil, errInputOutOfRange("message")
+	}
+
+	cmd, err := getCmdByCode(int(c.Code), int(c.SubCode))
+	if err != nil {
+		return nil, err
+	}
+
+	rp := makeResponsePacket(int(c.Vin), cmd, msg)
+	rp.Header.ResCode = code
+	return encodePacket(rp)
+}
+
+// EncodeReport encode report packet, published by device on report topic.
+func EncodeReport(rp *ReportPacket) ([]byte, error) {
+	return encodeReport(rp)
+}
+
+// Commands list all registered command invoker, ordered by code & subCode.
+func Commands() []string {
+	invokers := []string{}
+	for _, subCodes := range cmdList {
+		for _, cmd := range subCodes {
+			if cmd.invoker != "" {
+				invokers = append(invokers, cmd.invoker)
+			}
+		}
+	}
+	return invokers
+}
@@ -0,0 +1,77 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestDeviceCommand(t *testing.T) {
+	for _, invoker := range Commands() {
+		t.Run(invoker, func(t *testing.T) {
+			cmd, err := getCmdByInvoker(invoker)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+
+			msg := message{0x01, 0x02}
+			b, err := encodePacket(makeCommandPacket(testVin, cmd, msg))
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+
+			cp, err := DecodeCommand(b)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			if cp.Invoker != invoker || int(cp.Vin) != testVin || string(cp.Message) != string(msg) {
+				t.Fatalf("want %s %d %v, got %s %d %v", invoker, testVin, msg, cp.Invoker, cp.Vin, cp.Message)
+			}
+
+			b, err = cp.EncodeResponse(ResCodeOk, []byte("OK"))
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+
+			res, err := decodeResponse(b)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			if err := res.validateResponse(testVin, cmd); err != nil {
+				t.Error("want no error, got ", err)
+			}
+		})
+	}
+}
+
+func TestDeviceCommandInvalid(t *testing.T) {
+	cmd, _ := getCmdByInvoker("GenInfo")
+	b, _ := encodePacket(makeCommandPacket(testVin, cmd, nil))
+
+	testCases := []struct {
+		desc   string
+		packet packet
+		want   error
+	}{
+		{
+			desc:   "response packet",
+			packet: strToBytes(PREFIX_ACK),
+			want:   errInvalidPrefix,
+		},
+		{
+			desc:   "trailing bytes",
+			packet: append(append(packet{}, b...), 0x01),
+			want:   errInvalidSize,
+		},
+		{
+			desc:   "unknown command",
+			packet: append(append(packet{}, b[:9]...), 0x09, 0x05),
+			want:   errInvalidCmdCode,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			if _, err := DecodeCommand(tC.packet); err != tC.want {
+				t.Errorf("want %v, got %v", tC.want, err)
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package sdk
 
 import (
 	"bytes"
+	"crypto/rand"
 	"reflect"
 	"time"
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
 )
 
 // exec execute command and return the response.
@@ -22,11 +24,17 @@ func (c *commander) exec(invoker string, msg message) (message, error) {
 		return nil, err
 	}
 
+	start := time.Now()
 	if err := c.sendCommand(cmd, msg); err != nil {
+		c.client.observe().CommandDone(c.vin, invoker, time.Since(start), err)
 		return nil, err
 	}
 
-	return c.waitResponse(cmd)
+	res, err := c.waitResponse(cmd)
+	c.client.observe().CommandDone(c.vin, invoker, time.Since(start), err)
+
+	c.sleeper.Sleep(3 * time.Second)
+	return res, err
 }
 
 // sendCommand encode and send outgoing command.
@@ -41,17 +49,41 @@ func (c *commander) sendCommand(cmd *command, msg message) error {
 		return err
 	}
 
-	topic := setTopicVin(TOPIC_COMMAND, c.vin)
-	return c.client.pub(topic, 1, true, packet)
+	correlation := make([]byte, CORRELATION_DATA_LEN)
+	if _, err := rand.Read(correlation); err != nil {
+		return err
+	}
+	c.correlation.Store(correlation)
+
+	// on MQTT 5, retained command is dropped by broker once commander stop waiting
+	expiry := DEFAULT_ACK_TIMEOUT + cmd.timeout
+	props := mqtt5.Properties{
+		MessageExpiry:   mqtt5.Uint32(uint32((expiry + time.Second - 1) / time.Second)),
+		ResponseTopic:   c.client.topics.response.topic(c.vin),
+		CorrelationData: correlation,
+	}
+
+	topic := c.client.topics.command.topic(c.vin)
+	return c.client.pubProps(topic, 1, true, packet, props)
+}
+
+// correlated check if response belongs to the last command, by its correlation data.
+// Response without correlation data (MQTT 3.1.1 or device not echoing it) is accepted.
+func (c *commander) correlated(msg mqtt.Message) bool {
+	m, ok := msg.(interface{ Properties() mqtt5.Properties })
+	if !ok {
+		return true
+	}
+	data := m.Properties().CorrelationData
+	if data == nil {
+		return true
+	}
+	want, _ := c.correlation.Load().([]byte)
+	return bytes.Equal(data, want)
 }
 
 // waitResponse wait, decode and check of incomming ACK and RESPONSE packet.
 func (c *commander) waitResponse(cmd *command) (message, error) {
-	defer func() {
-		// c.flush()
-		c.sleeper.Sleep(3 * time.Second)
-	}()
-
 	packet, err := c.waitPacket("ack", DEFAULT_ACK_TIMEOUT)
 	if err != nil {
 		return nil, err
@@ -97,8 +129,8 @@ func (c *commander) waitPacket(name string, timeout time.Duration) (packet, erro
 // Destroy unsubscribe from command & response topic for current VIN.
 func (c *commander) Destroy() error {
 	topics := []string{
-		setTopicVin(TOPIC_COMMAND, c.vin),
-		setTopicVin(TOPIC_RESPONSE, c.vin),
+		c.client.topics.command.topic(c.vin),
+		c.client.topics.response.topic(c.vin),
 	}
 	return c.client.unsub(topics)
 }
@@ -110,18 +142,22 @@ func (c *commander) listen() error {
 	}
 
 	cFunc := func(client mqtt.Client, msg mqtt.Message) {
-		c.logger.Println(CMD, debugPacket(msg))
+		c.logger.packet(CMD, msg)
 	}
-	topic := setTopicVin(TOPIC_COMMAND, c.vin)
+	topic := c.client.topics.command.topic(c.vin)
 	if err := c.client.sub(topic, QOS_SUB_COMMAND, cFunc); err != nil {
 		return err
 	}
 
 	rFunc := func(client mqtt.Client, msg mqtt.Message) {
-		c.logger.Println(CMD, debugPacket(msg))
+		c.logger.packet(CMD, msg)
+		if !c.correlated(msg) {
+			c.logger.debug(CMD, "Ignored response of other command", "vin", c.vin)
+			return
+		}
 		c.resChan <- msg.Payload()
 	}
-	topic = setTopicVin(TOPIC_RESPONSE, c.vin)
+	topic = c.client.topics.response.topic(c.vin)
 	if err := c.client.sub(topic, QOS_SUB_RESPONSE, rFunc); err != nil {
 		return err
 	}
@@ -131,8 +167,8 @@ func (c *commander) listen() error {
 // flush clear command & response topic on client
 // It indicates that command is done or cancelled.
 func (c *commander) flush() {
-	for _, t := range []string{TOPIC_COMMAND, TOPIC_RESPONSE} {
-		_ = c.client.pub(setTopicVin(t, c.vin), QOS_CMD_FLUSH, true, nil)
+	for _, t := range []topicTemplate{c.client.topics.command, c.client.topics.response} {
+		_ = c.client.pub(t.topic(c.vin), QOS_CMD_FLUSH, true, nil)
 	}
 }
 
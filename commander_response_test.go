@@ -105,16 +105,16 @@ func TestResponseErrorHandler(t *testing.T) {
 		},
 		{
 			desc: "simulate code error, no message",
-			want: resCodeError.String(),
+			want: ResCodeError.String(),
 			modifier: func(r *responsePacket) {
-				r.Header.ResCode = resCodeError
+				r.Header.ResCode = ResCodeError
 			},
 		},
 		{
 			desc: "simulate code error, with message",
-			want: fmt.Sprint(resCodeError, " State should = ", BikeStateStandby),
+			want: fmt.Sprint(ResCodeError, " State should = ", BikeStateStandby),
 			modifier: func(r *responsePacket) {
-				r.Header.ResCode = resCodeError
+				r.Header.ResCode = ResCodeError
 				r.Message = message("State should = {1}")
 			},
 		},
@@ -134,3 +134,56 @@ func TestResponseErrorHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestResponseErrorKind(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		err      error
+		timeout  bool
+		packet   string
+		outRange bool
+		code     ResCode
+		isCode   bool
+	}{
+		{
+			desc:    "ack timeout",
+			err:     errPacketTimeout("ack"),
+			timeout: true,
+			packet:  "ack",
+			code:    ResCodeOk,
+		},
+		{
+			desc:     "out of range",
+			err:      fmt.Errorf("wrapped: %w", errInputOutOfRange("drive-mode")),
+			outRange: true,
+			code:     ResCodeOk,
+		},
+		{
+			desc:   "response invalid",
+			err:    errResponse{code: ResCodeInvalid},
+			code:   ResCodeInvalid,
+			isCode: true,
+		},
+		{
+			desc: "other",
+			err:  errPacketAckCorrupt,
+			code: ResCodeOk,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			if got := IsPacketTimeout(tC.err); got != tC.timeout {
+				t.Errorf("timeout want %v, got %v", tC.timeout, got)
+			}
+			if packet, _ := PacketTimeoutOf(tC.err); packet != tC.packet {
+				t.Errorf("packet want %q, got %q", tC.packet, packet)
+			}
+			if got := IsInputOutOfRange(tC.err); got != tC.outRange {
+				t.Errorf("out of range want %v, got %v", tC.outRange, got)
+			}
+			if code, ok := ResponseCode(tC.err); code != tC.code || ok != tC.isCode {
+				t.Errorf("want %s %v, got %s %v", tC.code, tC.isCode, code, ok)
+			}
+		})
+	}
+}
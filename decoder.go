@@ -12,7 +12,9 @@ import (
 )
 
 // decodeResponse extract header and message response from bytes packet.
-func decodeResponse(packet packet) (*responsePacket, error) {
+func decodeResponse(packet packet) (res *responsePacket, err error) {
+	defer recoverPanic(&err)
+
 	// TODO: redundant with decodeReport logic, implement DRY
 	reader := bytes.NewReader(packet)
 	result := &responsePacket{}
@@ -39,22 +41,51 @@ func decodeResponse(packet packet) (*responsePacket, error) {
 	return result, nil
 }
 
+// decodeCommand extract header and message command from bytes packet.
+func decodeCommand(packet packet) (cmd *commandPacket, err error) {
+	defer recoverPanic(&err)
+
+	reader := bytes.NewReader(packet)
+	result := &commandPacket{}
+	if err := decode(reader, result); err != nil {
+		return nil, err
+	}
+
+	if !result.validPrefix() {
+		return nil, errInvalidPrefix
+	}
+	if !result.validSize() {
+		return nil, errInvalidSize
+	}
+	if !result.validCmdCode() {
+		return nil, errInvalidCmdCode
+	}
+	return result, nil
+}
+
 // decodeReport extract report from bytes packet.
-func decodeReport(packet packet) (*ReportPacket, error) {
+func decodeReport(packet packet) (rp *ReportPacket, err error) {
+	defer recoverPanic(&err)
+
 	reportPacket := &ReportPacket{}
 
-	// get version
+	// get header
+	if len(packet) < getPacketSize(&Header{}) {
+		return nil, errInvalidSize
+	}
 	reader := bytes.NewReader(packet)
-	decode(reader, reportPacket)
-	rpStructure, isGot := ReportPacketStructures[int(reportPacket.Header.Version)]
-	if !isGot {
-		return nil, errors.New(fmt.Sprintf("Cannot handle report version %d", reportPacket.Header.Version))
+	if err := decode(reader, reportPacket); err != nil {
+		return nil, err
 	}
 
 	// Check validity
 	if !reportPacket.ValidPrefix() {
 		return nil, errInvalidPrefix
 	}
+	rpStructure, isGot := ReportPacketStructures[int(reportPacket.Header.Version)]
+	if !isGot {
+		return nil, fmt.Errorf("%w %d", errInvalidVersion, reportPacket.Header.Version)
+	}
 
 	// decode payload
 	payloadReader := bytes.NewReader(reportPacket.Payload)
@@ -70,6 +101,14 @@ func decodeReport(packet packet) (*ReportPacket, error) {
 	return reportPacket, nil
 }
 
+// recoverPanic convert panic while decoding hostile packet into error.
+// It should be deferred, err is pointer to named return value.
+func recoverPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("%w: %v", errPacketCorrupt, r)
+	}
+}
+
 // decode read buffer reader than decode and set it to v.
 // v is struct or pointer type that will contain decoded data.
 // fix bug. in "decode func" before, It'll be error for case such as v isn't array of struct.
@@ -368,6 +407,10 @@ func createZeroElm(dt VarDataType) (reflect.Value, reflect.Type) {
 
 // readUint read len(length) data as uint64
 func readUint(rdr io.Reader, len int) (uint64, error) {
+	if len < 0 || len > 8 {
+		return 0, errInvalidLength(len)
+	}
+
 	// sometimes, data recived in length less than 8
 	b := make([]byte, len)
 	err := binary.Read(rdr, binary.BigEndian, &b)
@@ -376,35 +419,20 @@ func readUint(rdr io.Reader, len int) (uint64, error) {
 	}
 
 	newb := make([]byte, 8)
-	for i := 0; i < len; i++ {
-		newb[i] = b[i]
-	}
+	copy(newb, b)
 	return binary.LittleEndian.Uint64(newb), nil
 }
 
 // readInt read len(length) data as int64 (signed int)
 func readInt(rdr io.Reader, len int) (int64, error) {
-	// sometimes, data recived in length less than 8
-	b := make([]byte, len)
-	err := binary.Read(rdr, binary.BigEndian, &b)
-	if err != nil {
-		return 0, err
+	x, err := readUint(rdr, len)
+	if err != nil || len == 0 || len == 8 {
+		return int64(x), err
 	}
 
-	var result int64 = 0
-
-	switch len {
-	case 1:
-		result = int64(int8(b[0]))
-	case 2:
-		result = int64(int16(binary.LittleEndian.Uint16(b)))
-	case 3, 4:
-		result = int64(int32(binary.LittleEndian.Uint32(b)))
-	default:
-		result = int64(binary.LittleEndian.Uint64(b))
-	}
-
-	return result, nil
+	// extend the sign bit of the last byte
+	shift := uint(64 - 8*len)
+	return int64(x<<shift) >> shift, nil
 }
 
 // convertToFloat64 convert bytes data to float64.
@@ -444,20 +472,28 @@ func convertToFloat64(typedata VarDataType, x uint64) (result float64) {
 
 // bytesToTime convert bytes slice (little endian) to time
 // value of bytes data is :
-//   1 byte of year
-//   1 byte of month
-//   1 byte of day
-//   1 byte of hour
-//   1 byte of minute
-//   1 byte of second
-//   1 byte of weekday (ignored)
+//
+//	1 byte of year
+//	1 byte of month
+//	1 byte of day
+//	1 byte of hour
+//	1 byte of minute
+//	1 byte of second
+//	1 byte of weekday (ignored)
 func bytesToTime(b []byte) time.Time {
-	var data string
-	for _, v := range b[:6] {
-		data += fmt.Sprintf("%02d", uint8(v))
+	if len(b) < 6 {
+		return time.Time{}
 	}
 
-	datetime, _ := time.Parse("060102150405", data)
+	year, month, day := 2000+int(b[0]), time.Month(b[1]), int(b[2])
+	hour, min, sec := int(b[3]), int(b[4]), int(b[5])
+	datetime := time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+
+	// reject out of range value, instead of normalizing it
+	if datetime.Month() != month || datetime.Day() != day ||
+		datetime.Hour() != hour || datetime.Minute() != min || datetime.Second() != sec {
+		return time.Time{}
+	}
 	return datetime
 }
 
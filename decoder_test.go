@@ -0,0 +1,235 @@
+package sdk
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestDecodeReportValidation(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		vin         int
+		modifier    func(rp *ReportPacket)
+		corrupter   func(p packet) packet
+		wantLenient error
+		wantStrict  error
+	}{
+		{
+			desc: "valid packet",
+		},
+		{
+			desc: "empty packet",
+			corrupter: func(p packet) packet {
+				return packet{}
+			},
+			wantLenient: errInvalidSize,
+			wantStrict:  errInvalidSize,
+		},
+		{
+			desc: "truncated header",
+			corrupter: func(p packet) packet {
+				return p[:5]
+			},
+			wantLenient: errInvalidSize,
+			wantStrict:  errInvalidSize,
+		},
+		{
+			desc: "invalid prefix",
+			modifier: func(rp *ReportPacket) {
+				rp.Header.Prefix = PREFIX_RESPONSE
+			},
+			wantLenient: errInvalidPrefix,
+			wantStrict:  errInvalidPrefix,
+		},
+		{
+			desc: "unknown version",
+			corrupter: func(p packet) packet {
+				p[3], p[4] = 0xFF, 0x00
+				return p
+			},
+			wantLenient: errInvalidVersion,
+			wantStrict:  errInvalidVersion,
+		},
+		{
+			desc: "trailing bytes",
+			corrupter: func(p packet) packet {
+				return append(p, 0x00, 0x01)
+			},
+			wantLenient: errInvalidSize,
+			wantStrict:  errInvalidSize,
+		},
+		{
+			desc: "truncated in the middle of field",
+			corrupter: func(p packet) packet {
+				// cut Gps.Longitude in half
+				return p[:47]
+			},
+			wantLenient: io.ErrUnexpectedEOF,
+			wantStrict:  io.ErrUnexpectedEOF,
+		},
+		{
+			desc: "declared size is not match",
+			corrupter: func(p packet) packet {
+				p[2]--
+				return p
+			},
+			wantStrict: errInvalidSize,
+		},
+		{
+			desc: "full frame with truncated payload",
+			corrupter: func(p packet) packet {
+				// drop the whole task section (22 bytes)
+				p = p[:len(p)-22]
+				p[2] = uint8(len(p) - 3)
+				return p
+			},
+			wantStrict: errInvalidSize,
+		},
+		{
+			desc: "simple frame with full payload",
+			modifier: func(rp *ReportPacket) {
+				rp.Data["Report"].(PacketData)["Frame"] = uint8(FrameSimple)
+			},
+			wantStrict: errInvalidSize,
+		},
+		{
+			desc:       "topic vin is not match",
+			vin:        testVin + 1,
+			wantStrict: errInvalidVin,
+		},
+		{
+			desc: "invalid frame",
+			modifier: func(rp *ReportPacket) {
+				rp.Data["Report"].(PacketData)["Frame"] = FrameLimit
+			},
+			wantStrict: errInvalidFrame,
+		},
+		{
+			desc: "send datetime is too old",
+			modifier: func(rp *ReportPacket) {
+				rp.Data["Report"].(PacketData)["SendDatetime"] = time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC)
+			},
+			wantStrict: errInvalidDatetime,
+		},
+		{
+			desc: "send datetime is in the future",
+			modifier: func(rp *ReportPacket) {
+				rp.Data["Report"].(PacketData)["SendDatetime"] = time.Now().Add(3 * REPORT_DATETIME_SKEW_MAX)
+			},
+			wantStrict: errInvalidDatetime,
+		},
+		{
+			desc: "send datetime is corrupted",
+			corrupter: func(p packet) packet {
+				p[10] = 0xFF
+				return p
+			},
+			wantStrict: errInvalidDatetime,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			rp := makeReportPacket(1, testVin, FrameFull)
+			if tC.modifier != nil {
+				tC.modifier(rp)
+			}
+
+			b, err := encodeReport(rp)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			if tC.corrupter != nil {
+				b = tC.corrupter(b)
+			}
+
+			vin := testVin
+			if tC.vin != 0 {
+				vin = tC.vin
+			}
+
+			policies := map[ReportPolicy]error{
+				ReportPolicyLenient: tC.wantLenient,
+				ReportPolicyStrict:  tC.wantStrict,
+			}
+			for policy, want := range policies {
+				got := validatePacket(b, vin, policy)
+				if !errors.Is(got, want) {
+					t.Errorf("%s want %v, got %v", policy, want, got)
+				}
+			}
+		})
+	}
+}
+
+// validatePacket decode and validate report packet with policy.
+func validatePacket(b packet, vin int, policy ReportPolicy) error {
+	rp, err := decodeReport(b)
+	if err != nil {
+		return err
+	}
+	return rp.validateReport(vin, policy)
+}
+
+func FuzzDecodeReport(f *testing.F) {
+	for version, tag := range ReportPacketStructures {
+		rp := &ReportPacket{
+			Header: Header{
+				Prefix:  PREFIX_REPORT,
+				Version: uint16(version),
+				Vin:     testVin,
+			},
+			Data: makeRandomData(tag).(PacketData),
+		}
+		b, err := encodeReport(rp)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add([]byte(b))
+		f.Add([]byte(b[:len(b)/2]))
+	}
+	f.Add([]byte{})
+	f.Add([]byte(strToBytes(PREFIX_REPORT)))
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		rp, err := decodeReport(b)
+		if errors.Is(err, errPacketCorrupt) {
+			t.Fatal("decoder panic, ", err)
+		}
+		if err != nil {
+			return
+		}
+		_ = rp.validateReport(testVin, ReportPolicyStrict)
+		_ = rp.String()
+	})
+}
+
+func FuzzDecodeResponse(f *testing.F) {
+	for _, invoker := range []string{"GenInfo", "FingerFetch", "NetReadSms"} {
+		cmd, err := getCmdByInvoker(invoker)
+		if err != nil {
+			f.Fatal(err)
+		}
+		b, err := encodePacket(makeResponsePacket(testVin, cmd, message("VCU v.664")))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add([]byte(b))
+		f.Add([]byte(b[:len(b)/2]))
+	}
+	f.Add([]byte{})
+	f.Add([]byte(strToBytes(PREFIX_ACK)))
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		res, err := decodeResponse(b)
+		if errors.Is(err, errPacketCorrupt) {
+			t.Fatal("decoder panic, ", err)
+		}
+		if err != nil {
+			return
+		}
+		res.renderMessage()
+	})
+}
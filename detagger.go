@@ -77,6 +77,19 @@ func (tag tagger) normalize() tagger {
 	return tag
 }
 
+// getSectionsSize calculate size of sub taggers by name, ex: sections of simple frame.
+func (tag tagger) getSectionsSize(names []string) int {
+	length := 0
+	for _, sub := range tag.Sub {
+		for _, name := range names {
+			if sub.Name == name {
+				length += sub.getSize()
+			}
+		}
+	}
+	return length
+}
+
 // getSize calculate tagger and sub tagger size
 func (tag tagger) getSize() int {
 	length := 0
@@ -37,10 +37,13 @@ func encodeReport(rp *ReportPacket) (packet, error) {
 
 	rpStructure, isGot := ReportPacketStructures[int(rp.Header.Version)]
 	if !isGot {
-		return nil, errors.New(fmt.Sprintf("Cannot handle report version %d", rp.Header.Version))
+		return nil, fmt.Errorf("%w %d", errInvalidVersion, rp.Header.Version)
 	}
 
 	payloadBytes, err := encode(rp.Data, rpStructure)
+	if err != nil {
+		return nil, err
+	}
 	packetBytes := append(headerBytes, payloadBytes...)
 
 	if packetBytes[2] == 0 {
@@ -85,7 +88,14 @@ func encode(v interface{}, tags ...tagger) (resBytes []byte, resError error) {
 			if len(tag.Sub) == 0 {
 				return nil, errors.New("Tag (" + tag.Name + "): Tipe Array_t cannot be 0")
 			}
-			rv = mapElm.MapIndex(reflect.ValueOf(tag.Name)).Elem()
+			rv = mapElm.MapIndex(reflect.ValueOf(tag.Name))
+			if !rv.IsValid() {
+				return buf.Bytes(), nil
+			}
+			rv = rv.Elem()
+			if rk := rv.Kind(); (rk == reflect.Array || rk == reflect.Slice) && rv.Len() != tag.Len {
+				return nil, fmt.Errorf("Tag (%s): Tipe Array_t need %d items", tag.Name, tag.Len)
+			}
 			break
 
 		default:
@@ -130,29 +140,20 @@ func encode(v interface{}, tags ...tagger) (resBytes []byte, resError error) {
 		}
 
 	case reflect.Array:
-		for j := 0; j < rv.Len(); j++ {
-			var b []byte
-			var err error
-
-			elm := rv.Index(j)
-			if elm.CanAddr() {
-				elm = elm.Addr()
-			}
-
-			if isVMaps {
-				b, err = encode(elm.Interface(), tag.Sub[0])
-			} else {
-				b, err = encode(elm.Interface())
-			}
+		b, err := encodeItems(rv, isVMaps, tag)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
 
+	case reflect.Slice:
+		if isVMaps {
+			b, err := encodeItems(rv, isVMaps, tag)
 			if err != nil {
 				return nil, err
 			}
 			buf.Write(b)
-		}
-
-	case reflect.Slice:
-		if rv.Type() == typeOfMessage && rv.Len() > 0 {
+		} else if rv.Type() == typeOfMessage && rv.Len() > 0 {
 			b := rv.Interface().(message)
 			buf.Write([]byte(b))
 		}
@@ -227,6 +228,9 @@ func encode(v interface{}, tags ...tagger) (resBytes []byte, resError error) {
 
 				if tagSub.Tipe == Struct_t {
 					rv = mapElm.MapIndex(reflect.ValueOf(tagSub.Name))
+					if !rv.IsValid() {
+						continue
+					}
 
 					b, err := encode(rv.Interface(), tagSub)
 					if err != nil {
@@ -258,6 +262,35 @@ func encode(v interface{}, tags ...tagger) (resBytes []byte, resError error) {
 	return buf.Bytes(), nil
 }
 
+// encodeItems encode each item of array or slice rv to bytes
+func encodeItems(rv reflect.Value, isVMaps bool, tag tagger) ([]byte, error) {
+	var buf bytes.Buffer
+	for j := 0; j < rv.Len(); j++ {
+		var b []byte
+		var err error
+
+		elm := rv.Index(j)
+		if elm.CanAddr() {
+			elm = elm.Addr()
+		}
+
+		if isVMaps {
+			if len(tag.Sub) == 0 {
+				return nil, errors.New("Tag (" + tag.Name + "): Tipe Array_t cannot be 0")
+			}
+			b, err = encode(elm.Interface(), tag.Sub[0])
+		} else {
+			b, err = encode(elm.Interface())
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
+
 // setVarOfTypeData create variable as typedata
 func setVarOfTypeData(typedata VarDataType) reflect.Value {
 	var rv reflect.Value
@@ -293,11 +326,18 @@ func setVarOfTypeData(typedata VarDataType) reflect.Value {
 	return rv.Elem()
 }
 
-// convertFloat64ToBytes convert float data to bytes
+// convertFloat64ToBytes convert float data to bytes.
+// v is rounded to nearest integer, signed type keep its two's complement.
 func convertFloat64ToBytes(typedata VarDataType, v float64) []byte {
 	rv := setVarOfTypeData(typedata)
-	b := uintToBytes(rv.Kind(), uint64(v))
-	return b
+	v = math.Round(v)
+
+	switch rv.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return uintToBytes(rv.Kind(), uint64(int64(v)))
+	default:
+		return uintToBytes(rv.Kind(), uint64(v))
+	}
 }
 
 // uintToBytes convert uint category type to byte slice (little endian)
@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEncodeReportRoundTrip(t *testing.T) {
+	const iterations = 200
+
+	for version, tag := range ReportPacketStructures {
+		t.Run(fmt.Sprint("version ", version), func(t *testing.T) {
+			for i := 0; i < iterations; i++ {
+				rp := &ReportPacket{
+					Header: Header{
+						Prefix:  PREFIX_REPORT,
+						Version: uint16(version),
+						Vin:     uint32(randInt(1, math.MaxInt32)),
+					},
+					Data: makeRandomData(tag).(PacketData),
+				}
+
+				b, err := encodeReport(rp)
+				if err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+
+				got, err := decodeReport(b)
+				if err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+				wantHeader := rp.Header
+				wantHeader.Size = uint8(len(b) - 3)
+				if got.Header != wantHeader {
+					t.Fatalf("header want %v, got %v", wantHeader, got.Header)
+				}
+				assertSameData(t, tag, rp.Data, got.Data, "")
+			}
+		})
+	}
+}
+
+func TestDecodeReadInt(t *testing.T) {
+	testCases := []struct {
+		bytes []byte
+		want  int64
+	}{
+		{bytes: []byte{0xFF}, want: -1},
+		{bytes: []byte{0x00, 0x80}, want: math.MinInt16},
+		{bytes: []byte{0xFE, 0xFF, 0xFF}, want: -2},
+		{bytes: []byte{0xFF, 0xFF, 0x7F}, want: 1<<23 - 1},
+		{bytes: []byte{0x01, 0x00, 0x00, 0x00, 0x00}, want: 1},
+		{bytes: []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, want: -1},
+	}
+	for _, tC := range testCases {
+		t.Run(byteToHex(tC.bytes), func(t *testing.T) {
+			got, err := readInt(bytes.NewReader(tC.bytes), len(tC.bytes))
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			if got != tC.want {
+				t.Errorf("want %d, got %d", tC.want, got)
+			}
+		})
+	}
+}
+
+// assertSameData compare decoded data with the original one.
+// Float is compared within its factor precision.
+func assertSameData(t *testing.T, tag tagger, want, got interface{}, path string) {
+	t.Helper()
+	tag = tag.normalize()
+
+	switch tag.Tipe {
+	case Struct_t:
+		for _, sub := range tag.Sub {
+			assertSameData(t, sub, want.(PacketData)[sub.Name], got.(PacketData)[sub.Name], path+"."+sub.Name)
+		}
+	case Array_t:
+		wantItems, gotItems := want.([]PacketData), got.([]PacketData)
+		if len(wantItems) != len(gotItems) {
+			t.Fatalf("%s want %d items, got %d", path, len(wantItems), len(gotItems))
+		}
+		for i := range wantItems {
+			assertSameData(t, tag.Sub[0], wantItems[i], gotItems[i], fmt.Sprintf("%s.[%d]", path, i))
+		}
+	case Time_t:
+		if !want.(time.Time).Equal(got.(time.Time)) {
+			t.Fatalf("%s want %v, got %v", path, want, got)
+		}
+	case Float_t:
+		w, g := float64(want.(float32)), float64(got.(float32))
+		precision := tag.Factor + math.Abs(w)*1e-6
+		if math.Abs(w-g) > precision {
+			t.Fatalf("%s want %v, got %v", path, w, g)
+		}
+	default:
+		if !reflect.DeepEqual(want, got) {
+			t.Fatalf("%s want %v (%T), got %v (%T)", path, want, want, got, got)
+		}
+	}
+}
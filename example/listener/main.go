@@ -69,19 +69,19 @@ func main() {
 	}
 
 	// listen to all vins
-	if err := api.AddListener(listener); err != nil {
+	if h, err := api.AddListener(listener); err != nil {
 		fmt.Println(err)
 	} else {
-		defer api.RemoveListener()
+		defer h.Remove()
 	}
 
 	// listen to range of vins
 	// see api.Addlistener doc for usage
 	// vins := sdk.VinRange(354309, 354323)
-	// if err := api.AddListener(listener, vins...); err != nil {
+	// if h, err := api.AddListener(listener, vins...); err != nil {
 	// 	fmt.Println(err)
 	// } else {
-	// 	defer api.RemoveListener(vins...)
+	// 	defer h.Remove()
 	// }
 
 	<-stopChan
@@ -0,0 +1,77 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// CSVEncoder encode report as CSV row. Columns are "vin", "version" then
+// sdk.ReportFields of the version, so the order is stable per version.
+// Missing values are empty, datetime fields are RFC3339 in UTC.
+type CSVEncoder struct{}
+
+// NewCSVEncoder create csv encoder.
+func NewCSVEncoder() *CSVEncoder {
+	return &CSVEncoder{}
+}
+
+// Columns return column names of version.
+func (e *CSVEncoder) Columns(version int) ([]string, error) {
+	fields, err := sdk.ReportFields(version)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]string, 0, len(fields)+2)
+	columns = append(columns, "vin", "version")
+	for _, f := range fields {
+		columns = append(columns, f.Path)
+	}
+	return columns, nil
+}
+
+// EncodeHeader write header row of version into w.
+func (e *CSVEncoder) EncodeHeader(w io.Writer, version int) error {
+	columns, err := e.Columns(version)
+	if err != nil {
+		return err
+	}
+	return writeCSV(w, columns)
+}
+
+// Encode write report row into w.
+func (e *CSVEncoder) Encode(w io.Writer, rp *sdk.ReportPacket) error {
+	version := int(rp.Header.Version)
+	fields, err := sdk.ReportFields(version)
+	if err != nil {
+		return err
+	}
+
+	row := make([]string, 0, len(fields)+2)
+	row = append(row,
+		strconv.FormatUint(uint64(rp.Header.Vin), 10),
+		strconv.Itoa(version),
+	)
+	for _, f := range fields {
+		v := rp.GetValue(f.Path)
+		if t, ok := v.(time.Time); ok {
+			row = append(row, t.UTC().Format(time.RFC3339))
+			continue
+		}
+		s, _ := formatValue(v)
+		row = append(row, s)
+	}
+	return writeCSV(w, row)
+}
+
+func writeCSV(w io.Writer, record []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,64 @@
+// Package export flatten reports into Influx line protocol or CSV, and batch them
+// into a writer or rotating files.
+//
+// Field names are GetValue paths of the report structure, ex: "Bms.Pack.[0].Voltage",
+// see sdk.ReportFields.
+//
+//	sink, _ := export.NewFileSink("data/reports.csv", export.NewCSVEncoder(), export.SinkOptions{})
+//	defer sink.Close()
+//	api.AddListener(sink.Listener())
+package export
+
+import (
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// Encoder encode a report into w.
+type Encoder interface {
+	Encode(w io.Writer, rp *sdk.ReportPacket) error
+}
+
+// HeaderEncoder is encoder which output start with a header per version, ex: CSV.
+type HeaderEncoder interface {
+	Encoder
+	EncodeHeader(w io.Writer, version int) error
+}
+
+type valueKind int
+
+const (
+	kindNone valueKind = iota
+	kindInt
+	kindFloat
+	kindBool
+	kindString
+	kindTime
+)
+
+// formatValue format decoded report value, kindNone if missing or unsupported.
+func formatValue(v interface{}) (string, valueKind) {
+	if t, ok := v.(time.Time); ok {
+		return strconv.FormatInt(t.Unix(), 10), kindTime
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), kindInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), kindInt
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 32), kindFloat
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), kindFloat
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), kindBool
+	case reflect.String:
+		return rv.String(), kindString
+	}
+	return "", kindNone
+}
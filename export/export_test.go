@@ -0,0 +1,275 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"github.com/garda-energi/gen.vcu.sdk/export"
+)
+
+const testVin = 354313
+
+var testTime = time.Date(2021, 12, 31, 23, 59, 59, 0, time.UTC)
+
+func TestInfluxEncoder(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		frame sdk.Frame
+		want  []string
+		none  []string
+	}{
+		{
+			desc:  "full frame",
+			frame: sdk.FrameFull,
+			want: []string{
+				"vcu_report,vin=354313,version=1 Report.SendDatetime=1640995199i,",
+				",Bms.SOC=80i,",
+				",Bms.Pack.[1].Voltage=60.5,",
+				",Gps.Latitude=-7.25,",
+				" 1640995199000000000\n",
+			},
+			none: []string{"Report.LogDatetime="},
+		},
+		{
+			desc:  "simple frame",
+			frame: sdk.FrameSimple,
+			want:  []string{"vcu_report,vin=354313,version=1 ", ",Gps.Latitude=-7.25,"},
+			none:  []string{"Bms.", "Report.LogDatetime="},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			rp := newReport(t, 1, tC.frame)
+
+			var buf bytes.Buffer
+			if err := export.NewInfluxEncoder("").Encode(&buf, rp); err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			got := buf.String()
+			if strings.Count(got, "\n") != 1 {
+				t.Fatalf("want single line, got %q", got)
+			}
+			for _, want := range tC.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("want %q, got %q", want, got)
+				}
+			}
+			for _, none := range tC.none {
+				if strings.Contains(got, none) {
+					t.Errorf("want no %q, got %q", none, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCSVEncoder(t *testing.T) {
+	for _, version := range []int{1, 3, 4} {
+		enc := export.NewCSVEncoder()
+		columns, err := enc.Columns(version)
+		if err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+		fields, _ := sdk.ReportFields(version)
+		if len(columns) != len(fields)+2 {
+			t.Fatalf("want %d columns, got %d", len(fields)+2, len(columns))
+		}
+
+		var buf bytes.Buffer
+		if err := enc.EncodeHeader(&buf, version); err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+		if err := enc.Encode(&buf, newReport(t, version, sdk.FrameSimple)); err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+
+		records := readCSV(t, buf.Bytes())
+		if len(records) != 2 {
+			t.Fatalf("want 2 records, got %d", len(records))
+		}
+		row := map[string]string{}
+		for i, col := range records[0] {
+			if col != columns[i] {
+				t.Errorf("column %d want %s, got %s", i, columns[i], col)
+			}
+			row[col] = records[1][i]
+		}
+		for col, want := range map[string]string{
+			"vin":                "354313",
+			"Gps.Latitude":       "-7.25",
+			"Report.LogDatetime": "2021-12-31T23:59:59Z",
+			"Bms.SOC":            "",
+		} {
+			if got := row[col]; got != want {
+				t.Errorf("%s want %q, got %q", col, want, got)
+			}
+		}
+	}
+
+	if _, err := export.NewCSVEncoder().Columns(99); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := export.NewWriterSink(&buf, export.NewCSVEncoder(), export.SinkOptions{
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+
+	for _, version := range []int{1, 1, 3} {
+		if err := sink.Write(newReport(t, version, sdk.FrameSimple)); err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+	}
+	if got := strings.Count(buf.String(), "\n"); got != 3 {
+		t.Errorf("want 1 batch of 3 lines, got %d lines", got)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if got := strings.Count(buf.String(), "vin,version,"); got != 2 {
+		t.Errorf("want header per version, got %d headers", got)
+	}
+	if err := sink.Write(newReport(t, 1, sdk.FrameSimple)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		enc      export.Encoder
+		versions []int
+		opts     export.SinkOptions
+		files    int
+	}{
+		{
+			desc:     "by size",
+			enc:      export.NewInfluxEncoder(""),
+			versions: []int{1, 1, 1},
+			opts:     export.SinkOptions{BatchSize: 1, MaxBytes: 1},
+			files:    3,
+		},
+		{
+			desc:     "no limit",
+			enc:      export.NewInfluxEncoder(""),
+			versions: []int{1, 1, 1},
+			opts:     export.SinkOptions{BatchSize: 1},
+			files:    1,
+		},
+		{
+			desc:     "by version",
+			enc:      export.NewCSVEncoder(),
+			versions: []int{1, 1, 2, 2, 1},
+			opts:     export.SinkOptions{BatchSize: 10},
+			files:    3,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			dir := t.TempDir()
+			sink, err := export.NewFileSink(filepath.Join(dir, "reports.out"), tC.enc, tC.opts)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			for _, version := range tC.versions {
+				if err := sink.Write(newReport(t, version, sdk.FrameSimple)); err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+			}
+			if err := sink.Close(); err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+
+			files, _ := filepath.Glob(filepath.Join(dir, "reports-*.out"))
+			if len(files) != tC.files {
+				t.Fatalf("want %d files, got %d", tC.files, len(files))
+			}
+
+			lines := 0
+			for _, file := range files {
+				b, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+				lines += strings.Count(string(b), "\n")
+				if _, ok := tC.enc.(export.HeaderEncoder); ok && !strings.HasPrefix(string(b), "vin,version,") {
+					t.Errorf("%s want header, got %.20q", file, b)
+				}
+			}
+			if _, ok := tC.enc.(export.HeaderEncoder); ok {
+				lines -= tC.files
+			}
+			if lines != len(tC.versions) {
+				t.Errorf("want %d reports, got %d", len(tC.versions), lines)
+			}
+		})
+	}
+}
+
+func TestFileSinkInterval(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := export.NewFileSink(filepath.Join(dir, "reports.lp"), export.NewInfluxEncoder(""), export.SinkOptions{
+		FlushInterval: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer sink.Close()
+
+	if err := sink.Write(newReport(t, 1, sdk.FrameSimple)); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		files, _ := filepath.Glob(filepath.Join(dir, "reports-*.lp"))
+		sort.Strings(files)
+		if len(files) == 1 {
+			if b, _ := os.ReadFile(files[0]); len(b) > 0 {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Error("want flushed by interval, got nothing")
+}
+
+func newReport(t *testing.T, version int, frame sdk.Frame) *sdk.ReportPacket {
+	t.Helper()
+
+	b := sdk.NewReportBuilder(version, testVin).
+		Frame(frame).
+		Set("Report.LogDatetime", testTime).
+		Set("Report.SendDatetime", testTime).
+		Set("Gps.Latitude", -7.25)
+	if frame == sdk.FrameFull {
+		b.Set("Bms.SOC", 80).Set("Bms.Pack.[1].Voltage", 60.5)
+	}
+	rp, err := b.Build()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	return rp
+}
+
+func readCSV(t *testing.T, b []byte) [][]string {
+	t.Helper()
+
+	r := csv.NewReader(bytes.NewReader(b))
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	return records
+}
@@ -0,0 +1,85 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// DEFAULT_MEASUREMENT is measurement name of InfluxEncoder.
+const DEFAULT_MEASUREMENT = "vcu_report"
+
+// timestampField is written as timestamp of the line instead of field.
+const timestampField = "Report.LogDatetime"
+
+// InfluxEncoder encode report as one line of Influx line protocol,
+// tagged by vin & version, and timestamped by Report.LogDatetime (nanosecond).
+// Missing values (ex: on simple frame) are skipped, datetime fields are unix seconds.
+type InfluxEncoder struct {
+	Measurement string
+}
+
+// NewInfluxEncoder create influx encoder, DEFAULT_MEASUREMENT is used if measurement is empty.
+func NewInfluxEncoder(measurement string) *InfluxEncoder {
+	if measurement == "" {
+		measurement = DEFAULT_MEASUREMENT
+	}
+	return &InfluxEncoder{Measurement: measurement}
+}
+
+// Encode write report line into w.
+func (e *InfluxEncoder) Encode(w io.Writer, rp *sdk.ReportPacket) error {
+	version := int(rp.Header.Version)
+	fields, err := sdk.ReportFields(version)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(influxEscaper.Replace(e.Measurement))
+	bw.WriteString(",vin=")
+	bw.WriteString(strconv.FormatUint(uint64(rp.Header.Vin), 10))
+	bw.WriteString(",version=")
+	bw.WriteString(strconv.Itoa(version))
+
+	sep := byte(' ')
+	for _, f := range fields {
+		if f.Path == timestampField {
+			continue
+		}
+		s, kind := formatValue(rp.GetValue(f.Path))
+		switch kind {
+		case kindNone:
+			continue
+		case kindInt, kindTime:
+			s += "i"
+		case kindString:
+			s = `"` + stringEscaper.Replace(s) + `"`
+		}
+		bw.WriteByte(sep)
+		bw.WriteString(influxEscaper.Replace(f.Path))
+		bw.WriteByte('=')
+		bw.WriteString(s)
+		sep = ','
+	}
+	if sep == ' ' {
+		// line protocol need at least one field
+		return nil
+	}
+
+	if t, ok := rp.GetValue(timestampField).(time.Time); ok {
+		bw.WriteByte(' ')
+		bw.WriteString(strconv.FormatInt(t.UnixNano(), 10))
+	}
+	bw.WriteByte('\n')
+	return bw.Flush()
+}
+
+var (
+	influxEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	stringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
@@ -0,0 +1,290 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+const (
+	// DEFAULT_BATCH_SIZE is number of reports buffered before flushed.
+	DEFAULT_BATCH_SIZE = 100
+	// DEFAULT_FLUSH_INTERVAL is maximum time report is buffered.
+	DEFAULT_FLUSH_INTERVAL = 5 * time.Second
+)
+
+// noVersion mark output which has no header yet.
+const noVersion = -1
+
+var errSinkClosed = errors.New("sink closed")
+
+// SinkOptions configure batching & file rotation, zero value use the defaults.
+type SinkOptions struct {
+	// BatchSize is number of reports buffered before flushed.
+	BatchSize int
+	// FlushInterval is maximum time report is buffered.
+	FlushInterval time.Duration
+	// MaxBytes rotate file when its size is reached, no limit if zero.
+	MaxBytes int64
+	// MaxAge rotate file when it is older, no limit if zero.
+	MaxAge time.Duration
+}
+
+// Sink batch encoded reports into a writer or rotating files.
+// For HeaderEncoder, header is written on each new file and when version changes,
+// file sink also rotate on version change so each file has single header.
+// Buffered reports are dropped when flush failed, see Err.
+type Sink struct {
+	enc  Encoder
+	opts SinkOptions
+
+	mutex   sync.Mutex
+	buf     bytes.Buffer
+	pending int
+	version int
+	err     error
+	closed  bool
+
+	out    io.Writer
+	path   string
+	file   *os.File
+	size   int64
+	opened time.Time
+	seq    int
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWriterSink create sink writing batches into w, w is not closed by sink.
+func NewWriterSink(w io.Writer, enc Encoder, opts SinkOptions) *Sink {
+	s := newSink(enc, opts)
+	s.out = w
+	s.start()
+	return s
+}
+
+// NewFileSink create sink writing batches into files rotated by MaxBytes & MaxAge.
+// Files are named after path with creation time & sequence,
+// ex: "data/reports.csv" into "data/reports-20211231T235959-0.csv".
+func NewFileSink(path string, enc Encoder, opts SinkOptions) (*Sink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	s := newSink(enc, opts)
+	s.path = path
+	s.start()
+	return s, nil
+}
+
+func newSink(enc Encoder, opts SinkOptions) *Sink {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DEFAULT_BATCH_SIZE
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DEFAULT_FLUSH_INTERVAL
+	}
+	return &Sink{
+		enc:     enc,
+		opts:    opts,
+		version: noVersion,
+		done:    make(chan struct{}),
+	}
+}
+
+// Listener return listener writing reports into sink, to be added on Sdk.
+func (s *Sink) Listener() sdk.Listener {
+	return sdk.Listener{
+		ReportFunc: func(vin int, rp *sdk.ReportPacket) {
+			s.Write(rp)
+		},
+	}
+}
+
+// Write encode report into buffer, and flush it when batch is full.
+func (s *Sink) Write(rp *sdk.ReportPacket) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return errSinkClosed
+	}
+
+	version := int(rp.Header.Version)
+	if enc, ok := s.enc.(HeaderEncoder); ok && version != s.version {
+		if s.path != "" && s.version != noVersion {
+			if err := s.flush(); err != nil {
+				return err
+			}
+			s.rotate()
+		}
+		if err := enc.EncodeHeader(&s.buf, version); err != nil {
+			return s.fail(err)
+		}
+		s.version = version
+	}
+
+	mark := s.buf.Len()
+	if err := s.enc.Encode(&s.buf, rp); err != nil {
+		s.buf.Truncate(mark)
+		return s.fail(err)
+	}
+	s.pending++
+
+	if s.pending >= s.opts.BatchSize {
+		return s.flush()
+	}
+	return nil
+}
+
+// Flush write buffered reports.
+func (s *Sink) Flush() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.flush()
+}
+
+// Err return last error of writing, as Listener can't return it.
+func (s *Sink) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.err
+}
+
+// Close flush buffered reports and close current file.
+func (s *Sink) Close() error {
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.done)
+	s.mutex.Unlock()
+
+	s.wg.Wait()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := s.flush()
+	if s.file != nil {
+		if cerr := s.file.Close(); err == nil {
+			err = cerr
+		}
+		s.file = nil
+	}
+	return err
+}
+
+// start flush & rotate periodically until closed.
+func (s *Sink) start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.opts.FlushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				s.mutex.Lock()
+				if s.flush() == nil && s.file != nil && s.expired() {
+					s.rotate()
+				}
+				s.mutex.Unlock()
+			}
+		}
+	}()
+}
+
+// flush write buffer into output, and rotate file when it is expired.
+func (s *Sink) flush() error {
+	if s.buf.Len() == 0 {
+		return nil
+	}
+	defer func() {
+		s.buf.Reset()
+		s.pending = 0
+	}()
+
+	w := s.out
+	if s.path != "" {
+		if s.file == nil {
+			if err := s.open(); err != nil {
+				return s.fail(err)
+			}
+		}
+		w = s.file
+	}
+
+	n, err := w.Write(s.buf.Bytes())
+	s.size += int64(n)
+	if err != nil {
+		return s.fail(err)
+	}
+
+	if s.file != nil && s.expired() {
+		s.rotate()
+	}
+	return nil
+}
+
+// open create next file of rotation.
+func (s *Sink) open() error {
+	ext := filepath.Ext(s.path)
+	name := fmt.Sprintf("%s-%s-%d%s",
+		strings.TrimSuffix(s.path, ext),
+		time.Now().UTC().Format("20060102T150405"),
+		s.seq,
+		ext,
+	)
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	s.seq++
+	s.file = file
+	s.size = 0
+	s.opened = time.Now()
+	return nil
+}
+
+// rotate close current file, next flush open a new one.
+func (s *Sink) rotate() {
+	if s.file == nil {
+		return
+	}
+	if err := s.file.Close(); err != nil {
+		s.fail(err)
+	}
+	s.file = nil
+	if _, ok := s.enc.(HeaderEncoder); ok {
+		s.version = noVersion
+	}
+}
+
+func (s *Sink) expired() bool {
+	if s.opts.MaxBytes > 0 && s.size >= s.opts.MaxBytes {
+		return true
+	}
+	return s.opts.MaxAge > 0 && time.Since(s.opened) >= s.opts.MaxAge
+}
+
+func (s *Sink) fail(err error) error {
+	s.err = err
+	return err
+}
@@ -0,0 +1,132 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// commanderType is type returned by sdk.NewCommander, used to lookup command methods.
+var commanderType = reflect.TypeOf((*sdk.Sdk).NewCommander).Out(0)
+
+var typeOfDuration = reflect.TypeOf(time.Duration(0))
+
+// errUnknownCommand is returned when invoker is not a commander method.
+var errUnknownCommand = errors.New("unknown command")
+
+// errInvalidArgs is returned when request arguments can't be decoded.
+type errInvalidArgs string
+
+func (e errInvalidArgs) Error() string {
+	return fmt.Sprintf("invalid args, %s", string(e))
+}
+
+// commander is instance created by sdk.NewCommander, its methods are called by reflection.
+type commander interface {
+	Destroy() error
+}
+
+// Command describe command & its argument types.
+type Command struct {
+	Name   string   `json:"name"`
+	Params []string `json:"params"`
+}
+
+// commands list all registered commands implemented by commander.
+func commands() []Command {
+	cmds := []Command{}
+	for _, invoker := range sdk.Commands() {
+		method, ok := commanderType.MethodByName(invoker)
+		if !ok {
+			continue
+		}
+		params := []string{}
+		for i := 1; i < method.Type.NumIn(); i++ {
+			params = append(params, typeName(method.Type.In(i)))
+		}
+		cmds = append(cmds, Command{Name: invoker, Params: params})
+	}
+	return cmds
+}
+
+// findInvoker lookup invoker by name, case insensitive.
+func findInvoker(name string) (string, error) {
+	for _, invoker := range sdk.Commands() {
+		if _, ok := commanderType.MethodByName(invoker); ok && strings.EqualFold(invoker, name) {
+			return invoker, nil
+		}
+	}
+	return "", errUnknownCommand
+}
+
+// invoke decode args and call invoker on cmder.
+// Enum args accept its name, ex: "SPORT", duration accept string, ex: "5s".
+func invoke(cmder commander, invoker string, args []json.RawMessage) (interface{}, error) {
+	method := reflect.ValueOf(cmder).MethodByName(invoker)
+	if !method.IsValid() {
+		return nil, errUnknownCommand
+	}
+
+	mt := method.Type()
+	if len(args) != mt.NumIn() {
+		return nil, errInvalidArgs(fmt.Sprintf("%s need %d argument(s), got %d", invoker, mt.NumIn(), len(args)))
+	}
+
+	ins := make([]reflect.Value, mt.NumIn())
+	for i := range ins {
+		v, err := decodeArg(mt.In(i), args[i])
+		if err != nil {
+			if sdk.IsInputOutOfRange(err) {
+				return nil, err
+			}
+			return nil, errInvalidArgs(fmt.Sprintf("argument %d: %s", i, err))
+		}
+		ins[i] = v
+	}
+
+	outs := method.Call(ins)
+	var res interface{}
+	if len(outs) > 1 {
+		res = outs[0].Interface()
+	}
+	if err, ok := outs[len(outs)-1].Interface().(error); ok && err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func decodeArg(t reflect.Type, raw json.RawMessage) (reflect.Value, error) {
+	v := reflect.New(t)
+	if t == typeOfDuration {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return v, err
+			}
+			return reflect.ValueOf(d), nil
+		}
+	}
+	if err := json.Unmarshal(raw, v.Interface()); err != nil {
+		return v, err
+	}
+	return v.Elem(), nil
+}
+
+// typeName render argument type for schema of commands.
+func typeName(t reflect.Type) string {
+	switch {
+	case t == typeOfDuration:
+		return "duration"
+	case t.Kind() == reflect.Slice:
+		return "[]" + typeName(t.Elem())
+	case t.PkgPath() != "":
+		return t.Name()
+	}
+	return t.Kind().String()
+}
@@ -0,0 +1,57 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// ErrorResponse is body of failed request.
+// Code is response code reported by device, if any.
+type ErrorResponse struct {
+	Error string `json:"error"`
+	Code  string `json:"code,omitempty"`
+}
+
+// statusOf map command error to http status code.
+func statusOf(err error) int {
+	var invalidArgs errInvalidArgs
+
+	switch {
+	case errors.As(err, &invalidArgs), sdk.IsInputOutOfRange(err):
+		return http.StatusBadRequest
+	case errors.Is(err, errUnknownCommand):
+		return http.StatusNotFound
+	case sdk.IsPacketTimeout(err):
+		return http.StatusGatewayTimeout
+	case sdk.IsClientDisconnected(err):
+		return http.StatusServiceUnavailable
+	}
+
+	if code, ok := sdk.ResponseCode(err); ok {
+		switch code {
+		case sdk.ResCodeInvalid:
+			return http.StatusUnprocessableEntity
+		default:
+			// device refused command, ex: on wrong bike state
+			return http.StatusConflict
+		}
+	}
+	return http.StatusBadGateway
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	res := ErrorResponse{Error: err.Error()}
+	if code, ok := sdk.ResponseCode(err); ok {
+		res.Code = code.String()
+	}
+	writeJson(w, status, res)
+}
+
+func writeJson(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,300 @@
+// Package gateway expose the SDK over HTTP/REST, for services not written in Go.
+//
+// Endpoints:
+//
+//	GET  /health                          mqtt connection health
+//	GET  /commands                        available commands & argument types
+//	POST /vins/{vin}/commands/{command}   execute command, body {"args": [...]}
+//	GET  /vins                            known VINs with last status
+//	GET  /vins/{vin}/status               last status
+//	GET  /vins/{vin}/report               last report
+//	GET  /schema                          known report versions
+//	GET  /schema/{version}                report structure of version
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// Gateway is http.Handler backed by connected Sdk.
+type Gateway struct {
+	api        *sdk.Sdk
+	listener   *sdk.ListenerHandle
+	store      *store
+	mutex      *sync.Mutex
+	commanders map[int]commander
+}
+
+// New create gateway and listen to status & report of vins (all if empty).
+// The api should be connected.
+func New(api *sdk.Sdk, vins ...int) (*Gateway, error) {
+	g := &Gateway{
+		api:        api,
+		store:      newStore(),
+		mutex:      &sync.Mutex{},
+		commanders: map[int]commander{},
+	}
+
+	listener := sdk.Listener{
+		StatusFunc: g.store.setStatus,
+		ReportFunc: g.store.setReport,
+	}
+	h, err := api.AddListener(listener, vins...)
+	if err != nil {
+		return nil, err
+	}
+	g.listener = h
+	return g, nil
+}
+
+// Close remove listener & destroy all commanders.
+func (g *Gateway) Close() error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	for vin, cmder := range g.commanders {
+		cmder.Destroy()
+		delete(g.commanders, vin)
+	}
+	return g.listener.Remove()
+}
+
+// ServeHTTP route request to endpoints.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case match(parts, "health"):
+		g.allow(w, r, http.MethodGet, g.health)
+	case match(parts, "commands"):
+		g.allow(w, r, http.MethodGet, g.commands)
+	case match(parts, "schema"):
+		g.allow(w, r, http.MethodGet, g.versions)
+	case match(parts, "schema", "*"):
+		g.allow(w, r, http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+			g.schema(w, parts[1])
+		})
+	case match(parts, "vins"):
+		g.allow(w, r, http.MethodGet, g.list)
+	case match(parts, "vins", "*", "status"):
+		g.allow(w, r, http.MethodGet, g.withVin(parts[1], g.status))
+	case match(parts, "vins", "*", "report"):
+		g.allow(w, r, http.MethodGet, g.withVin(parts[1], g.report))
+	case match(parts, "vins", "*", "commands", "*"):
+		g.allow(w, r, http.MethodPost, g.withVin(parts[1], func(w http.ResponseWriter, r *http.Request, vin int) {
+			g.command(w, r, vin, parts[3])
+		}))
+	default:
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+	}
+}
+
+type healthResponse struct {
+	Connected  bool      `json:"connected"`
+	State      string    `json:"state"`
+	Since      time.Time `json:"since"`
+	Reconnects int       `json:"reconnects"`
+	LastError  string    `json:"last_error,omitempty"`
+}
+
+func (g *Gateway) health(w http.ResponseWriter, r *http.Request) {
+	health := g.api.Health()
+	res := healthResponse{
+		Connected:  health.State == sdk.ConnStateConnected,
+		State:      health.State.String(),
+		Since:      health.Since,
+		Reconnects: health.Reconnects,
+	}
+	if health.LastError != nil {
+		res.LastError = health.LastError.Error()
+	}
+	// client still report connected while reconnecting, only the state is reliable
+	if !res.Connected {
+		writeJson(w, http.StatusServiceUnavailable, res)
+		return
+	}
+	writeJson(w, http.StatusOK, res)
+}
+
+func (g *Gateway) commands(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, http.StatusOK, commands())
+}
+
+func (g *Gateway) versions(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, http.StatusOK, map[string][]int{"versions": versions()})
+}
+
+func (g *Gateway) schema(w http.ResponseWriter, v string) {
+	version, err := strconv.Atoi(v)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("invalid version"))
+		return
+	}
+	f, ok := schema(version)
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("unknown version"))
+		return
+	}
+	writeJson(w, http.StatusOK, f)
+}
+
+// StatusResponse is last known status of VIN.
+type StatusResponse struct {
+	Vin      int        `json:"vin"`
+	Online   bool       `json:"online"`
+	StatusAt *time.Time `json:"status_at,omitempty"`
+	ReportAt *time.Time `json:"report_at,omitempty"`
+}
+
+func newStatusResponse(vin int, st state) StatusResponse {
+	res := StatusResponse{Vin: vin, Online: st.online}
+	if !st.statusAt.IsZero() {
+		res.StatusAt = &st.statusAt
+	}
+	if !st.reportAt.IsZero() {
+		res.ReportAt = &st.reportAt
+	}
+	return res
+}
+
+func (g *Gateway) list(w http.ResponseWriter, r *http.Request) {
+	res := []StatusResponse{}
+	for _, vin := range g.store.vins() {
+		if st, ok := g.store.lookup(vin); ok {
+			res = append(res, newStatusResponse(vin, st))
+		}
+	}
+	writeJson(w, http.StatusOK, res)
+}
+
+func (g *Gateway) status(w http.ResponseWriter, r *http.Request, vin int) {
+	st, ok := g.store.lookup(vin)
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("no status received"))
+		return
+	}
+	writeJson(w, http.StatusOK, newStatusResponse(vin, st))
+}
+
+// ReportResponse is last report of VIN, Report is ReportPacket.Json().
+type ReportResponse struct {
+	Vin      int             `json:"vin"`
+	ReportAt time.Time       `json:"report_at"`
+	Report   json.RawMessage `json:"report"`
+}
+
+func (g *Gateway) report(w http.ResponseWriter, r *http.Request, vin int) {
+	st, ok := g.store.lookup(vin)
+	if !ok || st.report == nil {
+		writeError(w, http.StatusNotFound, errors.New("no report received"))
+		return
+	}
+	writeJson(w, http.StatusOK, ReportResponse{
+		Vin:      vin,
+		ReportAt: st.reportAt,
+		Report:   st.report.Json(),
+	})
+}
+
+// CommandRequest is body of command endpoint.
+type CommandRequest struct {
+	Args []json.RawMessage `json:"args"`
+}
+
+// CommandResponse is result of succeed command.
+type CommandResponse struct {
+	Vin     int         `json:"vin"`
+	Command string      `json:"command"`
+	Result  interface{} `json:"result,omitempty"`
+}
+
+func (g *Gateway) command(w http.ResponseWriter, r *http.Request, vin int, name string) {
+	invoker, err := findInvoker(name)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	req := CommandRequest{}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, errInvalidArgs(err.Error()))
+			return
+		}
+	}
+
+	cmder, err := g.commander(vin)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+
+	res, err := invoke(cmder, invoker, req.Args)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	writeJson(w, http.StatusOK, CommandResponse{
+		Vin:     vin,
+		Command: invoker,
+		Result:  res,
+	})
+}
+
+// commander return cached commander of VIN, so command & response topic is subscribed once.
+// Commands to the same VIN are executed one at a time by the commander.
+func (g *Gateway) commander(vin int) (commander, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if cmder, ok := g.commanders[vin]; ok {
+		return cmder, nil
+	}
+	cmder, err := g.api.NewCommander(vin)
+	if err != nil {
+		return nil, err
+	}
+	g.commanders[vin] = cmder
+	return cmder, nil
+}
+
+func (g *Gateway) allow(w http.ResponseWriter, r *http.Request, method string, h http.HandlerFunc) {
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	h(w, r)
+}
+
+func (g *Gateway) withVin(v string, h func(http.ResponseWriter, *http.Request, int)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vin, err := strconv.Atoi(v)
+		if err != nil || vin < 0 {
+			writeError(w, http.StatusBadRequest, errors.New("invalid vin"))
+			return
+		}
+		h(w, r, vin)
+	}
+}
+
+// match check if path parts equal to pattern, "*" match any part.
+func match(parts []string, pattern ...string) bool {
+	if len(parts) != len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != parts[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,310 @@
+package gateway_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"github.com/garda-energi/gen.vcu.sdk/broker"
+	"github.com/garda-energi/gen.vcu.sdk/gateway"
+	"github.com/garda-energi/gen.vcu.sdk/sdktest"
+)
+
+const testVin = 354313
+
+func TestGatewayCommand(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		path    string
+		body    string
+		expect  func(f *sdktest.Fake)
+		status  int
+		want    string
+		resCode string
+	}{
+		{
+			desc: "ok with enum name",
+			path: "/vins/354313/commands/HbarDrive",
+			body: `{"args": ["sport"]}`,
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "HbarDrive").RespondOK()
+			},
+			status: http.StatusOK,
+		},
+		{
+			desc: "ok with result",
+			path: "/vins/354313/commands/geninfo",
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "GenInfo").RespondWith(sdk.ResCodeOk, []byte("VCU v.664"))
+			},
+			status: http.StatusOK,
+			want:   "VCU v.664",
+		},
+		{
+			desc: "ok with duration",
+			path: "/vins/354313/commands/ReportInterval",
+			body: `{"args": ["5s"]}`,
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "ReportInterval").RespondOK()
+			},
+			status: http.StatusOK,
+		},
+		{
+			desc:   "unknown command",
+			path:   "/vins/354313/commands/Fly",
+			expect: func(f *sdktest.Fake) {},
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "missing args",
+			path:   "/vins/354313/commands/HbarDrive",
+			expect: func(f *sdktest.Fake) {},
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "invalid enum",
+			path:   "/vins/354313/commands/HbarDrive",
+			body:   `{"args": ["turbo"]}`,
+			expect: func(f *sdktest.Fake) {},
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "input out of range",
+			path:   "/vins/354313/commands/McuSpeedMax",
+			body:   `{"args": [250, 1]}`,
+			expect: func(f *sdktest.Fake) {},
+			status: http.StatusBadRequest,
+		},
+		{
+			desc: "packet timeout",
+			path: "/vins/354313/commands/GenLed",
+			body: `{"args": [true]}`,
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "GenLed").NoAck()
+			},
+			status: http.StatusGatewayTimeout,
+		},
+		{
+			desc: "response error",
+			path: "/vins/354313/commands/GenLed",
+			body: `{"args": [false]}`,
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "GenLed").RespondError("State should = {1}")
+			},
+			status:  http.StatusConflict,
+			resCode: "ERROR",
+		},
+		{
+			desc: "response invalid",
+			path: "/vins/354313/commands/GenLed",
+			body: `{"args": [false]}`,
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "GenLed").RespondWith(sdk.ResCodeInvalid, nil)
+			},
+			status:  http.StatusUnprocessableEntity,
+			resCode: "INVALID",
+		},
+		{
+			desc:   "wrong method",
+			path:   "/vins/354313/commands/GenInfo",
+			expect: func(f *sdktest.Fake) {},
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			fake := sdktest.New()
+			srv := newGateway(t, fake)
+			tC.expect(fake)
+
+			method := http.MethodPost
+			if tC.status == http.StatusMethodNotAllowed {
+				method = http.MethodGet
+			}
+			res := request(t, srv, method, tC.path, tC.body)
+			if res.Code != tC.status {
+				t.Fatalf("want %d, got %d %s", tC.status, res.Code, res.Body)
+			}
+
+			switch {
+			case tC.status == http.StatusOK:
+				var body gateway.CommandResponse
+				json.Unmarshal(res.Body.Bytes(), &body)
+				if body.Vin != testVin {
+					t.Errorf("want %d, got %d", testVin, body.Vin)
+				}
+				if tC.want != "" && body.Result != tC.want {
+					t.Errorf("want %s, got %v", tC.want, body.Result)
+				}
+			case tC.resCode != "":
+				var body gateway.ErrorResponse
+				json.Unmarshal(res.Body.Bytes(), &body)
+				if body.Code != tC.resCode {
+					t.Errorf("want %s, got %s", tC.resCode, body.Code)
+				}
+			}
+			fake.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGatewayState(t *testing.T) {
+	fake := sdktest.New()
+	srv := newGateway(t, fake)
+
+	res := request(t, srv, http.MethodGet, "/vins/354313/report", "")
+	if res.Code != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, res.Code)
+	}
+
+	rp, err := sdk.NewReportBuilder(1, testVin).Set("Bms.SOC", 80).Build()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	fake.SetOnline(testVin, true)
+	if err := fake.EmitReport(testVin, rp); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	res = request(t, srv, http.MethodGet, "/vins/354313/status", "")
+	var status gateway.StatusResponse
+	json.Unmarshal(res.Body.Bytes(), &status)
+	if res.Code != http.StatusOK || !status.Online || status.ReportAt == nil {
+		t.Errorf("want online with report, got %d %s", res.Code, res.Body)
+	}
+
+	res = request(t, srv, http.MethodGet, "/vins/354313/report", "")
+	var report struct {
+		Report struct {
+			Bms struct {
+				SOC int
+			}
+		}
+	}
+	json.Unmarshal(res.Body.Bytes(), &report)
+	if res.Code != http.StatusOK || report.Report.Bms.SOC != 80 {
+		t.Errorf("want SOC 80, got %d %s", res.Code, res.Body)
+	}
+
+	res = request(t, srv, http.MethodGet, "/vins", "")
+	var list []gateway.StatusResponse
+	json.Unmarshal(res.Body.Bytes(), &list)
+	if len(list) != 1 || list[0].Vin != testVin {
+		t.Errorf("want [%d], got %s", testVin, res.Body)
+	}
+}
+
+func TestGatewaySchema(t *testing.T) {
+	fake := sdktest.New()
+	srv := newGateway(t, fake)
+
+	testCases := []struct {
+		path   string
+		status int
+	}{
+		{path: "/schema", status: http.StatusOK},
+		{path: "/schema/1", status: http.StatusOK},
+		{path: "/schema/99", status: http.StatusNotFound},
+		{path: "/schema/x", status: http.StatusBadRequest},
+		{path: "/health", status: http.StatusOK},
+		{path: "/commands", status: http.StatusOK},
+		{path: "/unknown", status: http.StatusNotFound},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.path, func(t *testing.T) {
+			res := request(t, srv, http.MethodGet, tC.path, "")
+			if res.Code != tC.status {
+				t.Errorf("want %d, got %d", tC.status, res.Code)
+			}
+		})
+	}
+
+	res := request(t, srv, http.MethodGet, "/schema/1", "")
+	if !strings.Contains(res.Body.String(), `"path":"Gps.Longitude"`) {
+		t.Error("want Gps.Longitude path in schema")
+	}
+
+	res = request(t, srv, http.MethodGet, "/health", "")
+	if !strings.Contains(res.Body.String(), `"state":"CONNECTED"`) {
+		t.Error("want CONNECTED state in health, got ", res.Body.String())
+	}
+}
+
+func TestGatewayHealthReconnecting(t *testing.T) {
+	var reject int32
+	b := broker.New()
+	b.Auth = func(user, pass string) bool {
+		return atomic.LoadInt32(&reject) == 0
+	}
+	if err := b.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { b.Close() })
+
+	api := sdk.New(sdk.ClientConfig{Host: b.Host(), Port: b.Port()}, false)
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(api.Disconnect)
+
+	g, err := gateway.New(&api)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { g.Close() })
+
+	res := request(t, g, http.MethodGet, "/health", "")
+	if res.Code != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, res.Code)
+	}
+
+	// broker refuse reconnect, so client stay reconnecting
+	atomic.StoreInt32(&reject, 1)
+	b.Disconnect()
+	deadline := time.Now().Add(5 * time.Second)
+	for api.State() != sdk.ConnStateReconnecting {
+		if time.Now().After(deadline) {
+			t.Fatal("want RECONNECTING, got ", api.State())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	res = request(t, g, http.MethodGet, "/health", "")
+	if res.Code != http.StatusServiceUnavailable {
+		t.Errorf("want %d, got %d", http.StatusServiceUnavailable, res.Code)
+	}
+	if !strings.Contains(res.Body.String(), `"connected":false,"state":"RECONNECTING"`) {
+		t.Error("want RECONNECTING state in health, got ", res.Body.String())
+	}
+}
+
+func newGateway(t *testing.T, fake *sdktest.Fake) *gateway.Gateway {
+	t.Helper()
+
+	api := fake.Sdk()
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(api.Disconnect)
+
+	g, err := gateway.New(&api)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { g.Close() })
+	return g
+}
+
+func request(t *testing.T, h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+	return res
+}
@@ -0,0 +1,90 @@
+package gateway
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// Field is report schema node, leaf field has no Sub.
+// Path is key accepted by ReportPacket.GetValue.
+type Field struct {
+	Name   string  `json:"name"`
+	Path   string  `json:"path,omitempty"`
+	Type   string  `json:"type"`
+	Len    int     `json:"len,omitempty"`
+	Factor float64 `json:"factor,omitempty"`
+	Sub    []Field `json:"sub,omitempty"`
+}
+
+// tag mirror exported fields of sdk's tagger.
+type tag struct {
+	Name   string
+	Tipe   string
+	Len    int
+	Factor float64
+	Sub    []tag
+}
+
+// schema return report structure of version.
+func schema(version int) (Field, bool) {
+	structure, ok := sdk.ReportPacketStructures[version]
+	if !ok {
+		return Field{}, false
+	}
+
+	b, err := json.Marshal(structure)
+	if err != nil {
+		return Field{}, false
+	}
+	var root tag
+	if err := json.Unmarshal(b, &root); err != nil {
+		return Field{}, false
+	}
+	return toField(root, ""), true
+}
+
+func toField(t tag, path string) Field {
+	f := Field{
+		Name:   t.Name,
+		Path:   path,
+		Type:   t.Tipe,
+		Len:    t.Len,
+		Factor: t.Factor,
+	}
+
+	switch sdk.VarDataType(t.Tipe) {
+	case sdk.Struct_t:
+		for _, sub := range t.Sub {
+			f.Sub = append(f.Sub, toField(sub, joinPath(path, sub.Name)))
+		}
+	case sdk.Array_t:
+		// array items share the same structure
+		for i := 0; i < t.Len && len(t.Sub) > 0; i++ {
+			item := t.Sub[0]
+			item.Name = "[" + strconv.Itoa(i) + "]"
+			f.Sub = append(f.Sub, toField(item, joinPath(path, item.Name)))
+		}
+	}
+	return f
+}
+
+// versions list known report versions.
+func versions() []int {
+	vs := []int{}
+	for v := range sdk.ReportPacketStructures {
+		vs = append(vs, v)
+	}
+	sort.Ints(vs)
+	return vs
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return strings.Join([]string{path, name}, ".")
+}
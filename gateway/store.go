@@ -0,0 +1,82 @@
+package gateway
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// state is last known status & report of a VIN.
+type state struct {
+	online   bool
+	statusAt time.Time
+	report   *sdk.ReportPacket
+	reportAt time.Time
+}
+
+// store keep last known state per VIN, fed by listener.
+type store struct {
+	mutex  *sync.RWMutex
+	states map[int]*state
+}
+
+func newStore() *store {
+	return &store{
+		mutex:  &sync.RWMutex{},
+		states: map[int]*state{},
+	}
+}
+
+func (s *store) get(vin int) *state {
+	st, ok := s.states[vin]
+	if !ok {
+		st = &state{}
+		s.states[vin] = st
+	}
+	return st
+}
+
+func (s *store) setStatus(vin int, online bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	st := s.get(vin)
+	st.online = online
+	st.statusAt = time.Now()
+}
+
+func (s *store) setReport(vin int, rp *sdk.ReportPacket) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	st := s.get(vin)
+	st.report = rp
+	st.reportAt = time.Now()
+}
+
+// lookup return copy of VIN's state.
+func (s *store) lookup(vin int) (state, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	st, ok := s.states[vin]
+	if !ok {
+		return state{}, false
+	}
+	return *st, true
+}
+
+// vins return all known VINs, ordered.
+func (s *store) vins() []int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	vins := make([]int, 0, len(s.states))
+	for vin := range s.states {
+		vins = append(vins, vin)
+	}
+	sort.Ints(vins)
+	return vins
+}
@@ -1,8 +1,25 @@
 module github.com/garda-energi/gen.vcu.sdk
 
-go 1.16
+go 1.18
 
 require (
 	github.com/eclipse/paho.mqtt.golang v1.3.5
-	golang.org/x/net v0.0.0-20211015210444-4f30a5c0130f // indirect
+	github.com/gorilla/websocket v1.4.2
+	github.com/prometheus/client_golang v1.14.0
+	google.golang.org/grpc v1.56.3
+	google.golang.org/protobuf v1.31.0
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/golang/protobuf v1.5.3 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/prometheus/client_model v0.3.0 // indirect
+	github.com/prometheus/common v0.37.0 // indirect
+	github.com/prometheus/procfs v0.8.0 // indirect
+	golang.org/x/net v0.12.0 // indirect
+	golang.org/x/sys v0.10.0 // indirect
+	golang.org/x/text v0.13.0 // indirect
+	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1 // indirect
 )
@@ -0,0 +1,204 @@
+package sdk
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+)
+
+// Field is a decoded packet field, located at Offset with Len bytes.
+type Field struct {
+	Name   string      `json:"name"`
+	Offset int         `json:"offset"`
+	Len    int         `json:"len"`
+	Raw    []byte      `json:"-"`
+	Value  interface{} `json:"value"`
+}
+
+// Inspection is detail of raw packet, used to debug captured packet offline.
+type Inspection struct {
+	Kind    PacketKind
+	Packet  []byte
+	Invoker string
+	Fields  []Field
+	Report  *ReportPacket
+}
+
+// Inspect detect packet kind by its prefix, decode it and locate each field.
+// On corrupted packet, the error is returned along with fields located so far.
+func Inspect(b []byte) (*Inspection, error) {
+	in := &Inspection{
+		Kind:   detectPacketKind(b),
+		Packet: b,
+	}
+
+	switch in.Kind {
+	case PacketKindAck:
+		in.addField("Prefix", 0, 2, PREFIX_ACK)
+		if len(b) != 2 {
+			return in, errInvalidSize
+		}
+		return in, nil
+	case PacketKindUnknown:
+		return in, errInvalidPrefix
+	}
+
+	in.inspectHeader()
+	switch in.Kind {
+	case PacketKindReport:
+		return in, in.inspectReport()
+	case PacketKindCommand:
+		return in, in.inspectCommand()
+	default:
+		return in, in.inspectResponse()
+	}
+}
+
+// detectPacketKind guess kind of b from its prefix.
+func detectPacketKind(b []byte) PacketKind {
+	if len(b) < 2 {
+		return PacketKindUnknown
+	}
+	switch string(reverseBytes(b[:2])) {
+	case PREFIX_REPORT:
+		return PacketKindReport
+	case PREFIX_COMMAND:
+		return PacketKindCommand
+	case PREFIX_ACK:
+		return PacketKindAck
+	case PREFIX_RESPONSE:
+		return PacketKindResponse
+	}
+	return PacketKindUnknown
+}
+
+// inspectHeader locate common header fields.
+func (in *Inspection) inspectHeader() {
+	b := in.Packet
+	in.addField("Prefix", 0, 2, string(reverseBytes(b[:2])))
+	if len(b) > 2 {
+		in.addField("Size", 2, 1, b[2])
+	}
+	if len(b) >= 5 {
+		in.addField("Version", 3, 2, binary.LittleEndian.Uint16(b[3:5]))
+	}
+	if len(b) >= 9 {
+		in.addField("Vin", 5, 4, binary.LittleEndian.Uint32(b[5:9]))
+	}
+}
+
+// inspectReport decode report and locate payload fields using version structure.
+func (in *Inspection) inspectReport() error {
+	rp, err := decodeReport(in.Packet)
+	if err == nil {
+		in.Report = rp
+	}
+
+	if len(in.Packet) < 5 {
+		return errInvalidSize
+	}
+	version := binary.LittleEndian.Uint16(in.Packet[3:5])
+	tag, isGot := ReportPacketStructures[int(version)]
+	if !isGot {
+		return err
+	}
+
+	offset := getPacketSize(&Header{})
+	in.inspectTag(tag, "", &offset)
+	if offset < len(in.Packet) {
+		in.addField("(unknown)", offset, len(in.Packet)-offset, nil)
+	}
+	return err
+}
+
+// inspectTag walk tag structure and add leaf fields, advancing offset.
+func (in *Inspection) inspectTag(tag tagger, path string, offset *int) {
+	switch tag.Tipe {
+	case Struct_t:
+		for _, sub := range tag.Sub {
+			in.inspectTag(sub, joinPath(path, sub.Name), offset)
+		}
+	case Array_t:
+		if len(tag.Sub) == 0 {
+			return
+		}
+		for i := 0; i < tag.Len; i++ {
+			item := joinPath(path, "["+strconv.Itoa(i)+"]")
+			in.inspectTag(tag.Sub[0], item, offset)
+		}
+	default:
+		size := tag.getSize()
+		if *offset+size <= len(in.Packet) {
+			var value interface{}
+			if in.Report != nil {
+				value = in.Report.GetValue(path)
+			}
+			in.addField(path, *offset, size, value)
+		}
+		*offset += size
+	}
+}
+
+// inspectCommand decode command code & message.
+func (in *Inspection) inspectCommand() error {
+	cmd, err := DecodeCommand(in.Packet)
+	if err == nil {
+		in.Invoker = cmd.Invoker
+	}
+
+	in.addCodeFields()
+	if len(in.Packet) > 11 {
+		in.addField("Message", 11, len(in.Packet)-11, in.Packet[11:])
+	}
+	return err
+}
+
+// inspectResponse decode response code & message.
+func (in *Inspection) inspectResponse() error {
+	res, err := decodeResponse(in.Packet)
+	if err == nil {
+		cmd, _ := getCmdByCode(int(res.Header.Code), int(res.Header.SubCode))
+		in.Invoker = cmd.invoker
+	}
+
+	in.addCodeFields()
+	if len(in.Packet) > 11 {
+		code := ResCode(in.Packet[11])
+		var value interface{} = in.Packet[11]
+		if code < ResCodeLimit {
+			value = code.String()
+		}
+		in.addField("ResCode", 11, 1, value)
+	}
+	if len(in.Packet) > 12 {
+		in.addField("Message", 12, len(in.Packet)-12, string(in.Packet[12:]))
+	}
+	return err
+}
+
+// addCodeFields add command code & sub code fields.
+func (in *Inspection) addCodeFields() {
+	if len(in.Packet) > 9 {
+		in.addField("Code", 9, 1, in.Packet[9])
+	}
+	if len(in.Packet) > 10 {
+		in.addField("SubCode", 10, 1, in.Packet[10])
+	}
+}
+
+func (in *Inspection) addField(name string, offset, length int, value interface{}) {
+	in.Fields = append(in.Fields, Field{
+		Name:   name,
+		Offset: offset,
+		Len:    length,
+		Raw:    in.Packet[offset : offset+length],
+		Value:  value,
+	})
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return strings.Join([]string{path, name}, ".")
+}
@@ -0,0 +1,153 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInspectReport(t *testing.T) {
+	for version := range ReportPacketStructures {
+		for _, frame := range []Frame{FrameSimple, FrameFull} {
+			t.Run(fmt.Sprint("version ", version, " ", frame), func(t *testing.T) {
+				b, err := NewReportBuilder(version, testVin).
+					Frame(frame).
+					Set("Vcu.State", BikeStateRun).
+					Encode()
+				if err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+
+				in, err := Inspect(b)
+				if err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+				if in.Kind != PacketKindReport {
+					t.Fatalf("want %s, got %s", PacketKindReport, in.Kind)
+				}
+				if in.Report == nil {
+					t.Fatal("want report, got nil")
+				}
+
+				offset := 0
+				for _, f := range in.Fields {
+					if f.Offset != offset {
+						t.Fatalf("%s offset want %d, got %d", f.Name, offset, f.Offset)
+					}
+					offset += f.Len
+				}
+				if offset != len(b) {
+					t.Errorf("fields want %d bytes, got %d", len(b), offset)
+				}
+
+				for _, f := range in.Fields {
+					if f.Name == "Vcu.State" && f.Value != in.Report.GetValue("Vcu.State") {
+						t.Errorf("want %v, got %v", in.Report.GetValue("Vcu.State"), f.Value)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestInspectCommand(t *testing.T) {
+	cmd, _ := getCmdByInvoker("HbarDrive")
+	b, _ := encodePacket(makeCommandPacket(testVin, cmd, message{byte(ModeDriveSport)}))
+
+	cp, _ := DecodeCommand(b)
+	res, _ := cp.EncodeResponse(ResCodeError, []byte("ERROR"))
+
+	testCases := []struct {
+		desc    string
+		packet  packet
+		kind    PacketKind
+		invoker string
+		fields  []string
+	}{
+		{
+			desc:    "command",
+			packet:  b,
+			kind:    PacketKindCommand,
+			invoker: "HbarDrive",
+			fields:  []string{"Prefix", "Size", "Version", "Vin", "Code", "SubCode", "Message"},
+		},
+		{
+			desc:    "response",
+			packet:  res,
+			kind:    PacketKindResponse,
+			invoker: "HbarDrive",
+			fields:  []string{"Prefix", "Size", "Version", "Vin", "Code", "SubCode", "ResCode", "Message"},
+		},
+		{
+			desc:   "ack",
+			packet: EncodeAck(),
+			kind:   PacketKindAck,
+			fields: []string{"Prefix"},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			in, err := Inspect(tC.packet)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			if in.Kind != tC.kind {
+				t.Errorf("want %s, got %s", tC.kind, in.Kind)
+			}
+			if in.Invoker != tC.invoker {
+				t.Errorf("want %s, got %s", tC.invoker, in.Invoker)
+			}
+			if len(in.Fields) != len(tC.fields) {
+				t.Fatalf("want %d fields, got %d", len(tC.fields), len(in.Fields))
+			}
+			for i, f := range in.Fields {
+				if f.Name != tC.fields[i] {
+					t.Errorf("want %s, got %s", tC.fields[i], f.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestInspectInvalid(t *testing.T) {
+	b, _ := NewReportBuilder(1, testVin).Encode()
+
+	testCases := []struct {
+		desc   string
+		packet packet
+		kind   PacketKind
+		fields int
+	}{
+		{
+			desc:   "unknown prefix",
+			packet: packet{0x01, 0x02, 0x03},
+			kind:   PacketKindUnknown,
+			fields: 0,
+		},
+		{
+			desc:   "truncated report",
+			packet: b[:20],
+			kind:   PacketKindReport,
+			fields: 5,
+		},
+		{
+			desc:   "ack with trailing bytes",
+			packet: append(EncodeAck(), 0x01),
+			kind:   PacketKindAck,
+			fields: 1,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			in, err := Inspect(tC.packet)
+			if err == nil {
+				t.Error("want error, got nil")
+			}
+			if in.Kind != tC.kind {
+				t.Errorf("want %s, got %s", tC.kind, in.Kind)
+			}
+			if len(in.Fields) != tC.fields {
+				t.Errorf("want %d fields, got %d", tC.fields, len(in.Fields))
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+// Package metrics export fleet telemetry & SDK internals as Prometheus metrics.
+//
+// Reports are turned into gauges labelled by VIN, while SDK internals
+// (reports decoded, command latency, timeouts & reconnects) are observed via sdk.Observer.
+// Listener worker pool is exported by Dispatch.
+//
+//	exp := metrics.New()
+//	api.Observe(exp)
+//	api.AddListener(exp.Listener())
+//	go exp.ListenAndServe(":9100")
+package metrics
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// NAMESPACE is prefix of all metric names.
+const NAMESPACE = "vcu"
+
+// Exporter collect metrics on its own registry, it implements sdk.Observer.
+type Exporter struct {
+	registry *prometheus.Registry
+
+	online     *prometheus.GaugeVec
+	reportTime *prometheus.GaugeVec
+	soc        *prometheus.GaugeVec
+	packVolt   *prometheus.GaugeVec
+	packAmp    *prometheus.GaugeVec
+	packTemp   *prometheus.GaugeVec
+	speed      *prometheus.GaugeVec
+	signal     *prometheus.GaugeVec
+	eeprom     *prometheus.GaugeVec
+	taskStack  *prometheus.GaugeVec
+
+	reports    *prometheus.CounterVec
+	commands   *prometheus.HistogramVec
+	timeouts   *prometheus.CounterVec
+	reconnects prometheus.Counter
+}
+
+// New create exporter with all collectors registered, including go & process collectors.
+func New() *Exporter {
+	e := &Exporter{
+		registry: prometheus.NewRegistry(),
+
+		online:     gauge("online", "1 if VIN is online, 0 otherwise.", "vin"),
+		reportTime: gauge("report_timestamp_seconds", "Log datetime of last report.", "vin"),
+		soc:        gauge("bms_soc_percent", "Battery state of charge.", "vin"),
+		packVolt:   gauge("bms_pack_voltage_volts", "Battery pack voltage.", "vin", "pack"),
+		packAmp:    gauge("bms_pack_current_amperes", "Battery pack current.", "vin", "pack"),
+		packTemp:   gauge("bms_pack_temperature_celsius", "Battery pack temperature.", "vin", "pack"),
+		speed:      gauge("speed_kph", "Motor speed.", "vin"),
+		signal:     gauge("net_signal_percent", "Cellular signal quality.", "vin"),
+		eeprom:     gauge("eeprom_used", "EEPROM used.", "vin"),
+		taskStack:  gauge("task_stack_min", "Minimum free stack of RTOS task.", "vin", "task"),
+
+		reports: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "reports_total",
+			Help:      "Received reports by version & result (ok, failed).",
+		}, []string{"version", "result"}),
+		commands: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: NAMESPACE,
+			Name:      "command_duration_seconds",
+			Help:      "Command latency from sent until response, by invoker & result (ok, timeout, error).",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30, 60},
+		}, []string{"invoker", "result"}),
+		timeouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "command_timeouts_total",
+			Help:      "Command timeouts by invoker & packet (ack, response).",
+		}, []string{"invoker", "packet"}),
+		reconnects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "reconnects_total",
+			Help:      "Connection re-established after lost.",
+		}),
+	}
+
+	e.registry.MustRegister(
+		e.online, e.reportTime, e.soc, e.packVolt, e.packAmp, e.packTemp,
+		e.speed, e.signal, e.eeprom, e.taskStack,
+		e.reports, e.commands, e.timeouts, e.reconnects,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return e
+}
+
+func gauge(name, help string, labels ...string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: NAMESPACE,
+		Name:      name,
+		Help:      help,
+	}, labels)
+}
+
+// Registry return registry of exporter, to add custom collectors.
+func (e *Exporter) Registry() *prometheus.Registry {
+	return e.registry
+}
+
+// Handler return http handler of metrics.
+func (e *Exporter) Handler() http.Handler {
+	return promhttp.HandlerFor(e.registry, promhttp.HandlerOpts{})
+}
+
+// ListenAndServe serve /metrics on addr, ex: ":9100".
+func (e *Exporter) ListenAndServe(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e.Handler())
+	return http.ListenAndServe(addr, mux)
+}
+
+// Listener return listener feeding the gauges, to be added on Sdk.
+func (e *Exporter) Listener() sdk.Listener {
+	return sdk.Listener{
+		StatusFunc: e.status,
+		ReportFunc: e.report,
+	}
+}
+
+func (e *Exporter) status(vin int, online bool) {
+	v := 0.0
+	if online {
+		v = 1
+	}
+	e.online.WithLabelValues(strconv.Itoa(vin)).Set(v)
+}
+
+// report update gauges from report, missing value (ex: on simple frame) is left unchanged.
+func (e *Exporter) report(vin int, rp *sdk.ReportPacket) {
+	label := strconv.Itoa(vin)
+
+	if t, ok := rp.GetValue("Report.LogDatetime").(time.Time); ok {
+		e.reportTime.WithLabelValues(label).Set(float64(t.UnixNano()) / 1e9)
+	}
+	setGauge(e.soc.WithLabelValues(label), rp.GetValue("Bms.SOC"))
+	setGauge(e.speed.WithLabelValues(label), rp.GetValue("Mcu.Speed"))
+	setGauge(e.signal.WithLabelValues(label), rp.GetValue("Net.Signal"))
+	setGauge(e.eeprom.WithLabelValues(label), rp.GetValue("Eeprom.Used"))
+
+	if packs, ok := rp.GetValue("Bms.Pack").([]sdk.PacketData); ok {
+		for i, pack := range packs {
+			idx := strconv.Itoa(i)
+			setGauge(e.packVolt.WithLabelValues(label, idx), pack["Voltage"])
+			setGauge(e.packAmp.WithLabelValues(label, idx), pack["Current"])
+			setGauge(e.packTemp.WithLabelValues(label, idx), pack["Temperature"])
+		}
+	}
+
+	if stack, ok := rp.GetValue("Task.Stack").(sdk.PacketData); ok {
+		for task, v := range stack {
+			setGauge(e.taskStack.WithLabelValues(label, task), v)
+		}
+	}
+}
+
+// setGauge set numeric value to gauge, other value is ignored.
+func setGauge(g prometheus.Gauge, v interface{}) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		g.Set(float64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		g.Set(float64(rv.Uint()))
+	case reflect.Float32, reflect.Float64:
+		g.Set(rv.Float())
+	}
+}
+
+// ReportReceived count received report by version & result.
+func (e *Exporter) ReportReceived(vin int, version int, err error) {
+	v := "unknown"
+	if version >= 0 {
+		v = strconv.Itoa(version)
+	}
+	result := "ok"
+	if err != nil {
+		result = "failed"
+	}
+	e.reports.WithLabelValues(v, result).Inc()
+}
+
+// CommandDone observe command latency & timeouts.
+func (e *Exporter) CommandDone(vin int, invoker string, elapsed time.Duration, err error) {
+	result := "ok"
+	if packet, ok := sdk.PacketTimeoutOf(err); ok {
+		result = "timeout"
+		e.timeouts.WithLabelValues(invoker, packet).Inc()
+	} else if err != nil {
+		result = "error"
+	}
+	e.commands.WithLabelValues(invoker, result).Observe(elapsed.Seconds())
+}
+
+// Reconnected count reconnects.
+func (e *Exporter) Reconnected() {
+	e.reconnects.Inc()
+}
+
+// Dispatch export queue depth & drops of listener worker pool, ex: exp.Dispatch(api.DispatchStats).
+// It should be called once.
+func (e *Exporter) Dispatch(stats func() sdk.DispatchStats) {
+	e.registry.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Name:      "dispatch_queue_depth",
+			Help:      "Messages waiting on listener workers.",
+		}, func() float64 {
+			return float64(stats().Queued)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: NAMESPACE,
+			Name:      "dispatch_queue_capacity",
+			Help:      "Maximum messages waiting on listener workers.",
+		}, func() float64 {
+			return float64(stats().Capacity)
+		}),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "dispatch_dropped_total",
+			Help:      "Messages dropped by full listener worker queue.",
+		}, func() float64 {
+			return float64(stats().Dropped)
+		}),
+	)
+}
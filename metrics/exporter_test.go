@@ -0,0 +1,131 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"github.com/garda-energi/gen.vcu.sdk/metrics"
+	"github.com/garda-energi/gen.vcu.sdk/sdktest"
+)
+
+const testVin = 354313
+
+func TestExporterReport(t *testing.T) {
+	fake := sdktest.New()
+	exp, api := newExporter(t, fake)
+
+	if _, err := api.AddListener(exp.Listener(), testVin); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	rp, err := sdk.NewReportBuilder(1, testVin).
+		Frame(sdk.FrameFull).
+		Set("Bms.SOC", 80).
+		Set("Bms.Pack.[1].Voltage", 60.5).
+		Set("Net.Signal", 70).
+		Set("Task.Stack.Manager", 120).
+		Build()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	fake.SetOnline(testVin, true)
+	if err := fake.EmitReport(testVin, rp); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	fake.Emit("VCU/354313/RPT", []byte("@T"))
+
+	body := scrape(t, exp)
+	for _, want := range []string{
+		`vcu_online{vin="354313"} 1`,
+		`vcu_bms_soc_percent{vin="354313"} 80`,
+		`vcu_bms_pack_voltage_volts{pack="1",vin="354313"} 60.5`,
+		`vcu_net_signal_percent{vin="354313"} 70`,
+		`vcu_task_stack_min{task="Manager",vin="354313"} 120`,
+		`vcu_reports_total{result="ok",version="1"} 1`,
+		`vcu_reports_total{result="failed",version="unknown"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("want %s, got nothing", want)
+		}
+	}
+}
+
+func TestExporterCommand(t *testing.T) {
+	fake := sdktest.New()
+	exp, api := newExporter(t, fake)
+
+	cmder, err := api.NewCommander(testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cmder.Destroy()
+
+	fake.ExpectCommand(testVin, "GenLed").RespondOK()
+	fake.ExpectCommand(testVin, "GenInfo").NoResponse()
+	if err := cmder.GenLed(true); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if _, err := cmder.GenInfo(); !sdk.IsPacketTimeout(err) {
+		t.Fatal("want timeout, got ", err)
+	}
+	exp.Reconnected()
+
+	body := scrape(t, exp)
+	for _, want := range []string{
+		`vcu_command_duration_seconds_count{invoker="GenLed",result="ok"} 1`,
+		`vcu_command_duration_seconds_count{invoker="GenInfo",result="timeout"} 1`,
+		`vcu_command_timeouts_total{invoker="GenInfo",packet="response"} 1`,
+		`vcu_reconnects_total 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("want %s, got nothing", want)
+		}
+	}
+}
+
+func TestExporterDispatch(t *testing.T) {
+	exp := metrics.New()
+	exp.Dispatch(func() sdk.DispatchStats {
+		return sdk.DispatchStats{Workers: 2, Queued: 3, Capacity: 8, Dropped: 5}
+	})
+
+	body := scrape(t, exp)
+	for _, want := range []string{
+		`vcu_dispatch_queue_depth 3`,
+		`vcu_dispatch_queue_capacity 8`,
+		`vcu_dispatch_dropped_total 5`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("want %s, got nothing", want)
+		}
+	}
+}
+
+func newExporter(t *testing.T, fake *sdktest.Fake) (*metrics.Exporter, *sdk.Sdk) {
+	t.Helper()
+
+	api := fake.Sdk()
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(api.Disconnect)
+
+	exp := metrics.New()
+	api.Observe(exp)
+	return exp, &api
+}
+
+func scrape(t *testing.T, exp *metrics.Exporter) string {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	res := httptest.NewRecorder()
+	exp.Handler().ServeHTTP(res, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("want %d, got %d", http.StatusOK, res.Code)
+	}
+	return res.Body.String()
+}
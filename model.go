@@ -25,7 +25,7 @@ type HeaderCommand struct {
 
 type headerResponse struct {
 	HeaderCommand
-	ResCode resCode `type:"uint8"`
+	ResCode ResCode `type:"uint8"`
 }
 
 // message is type for command & response message (last field)
@@ -4,7 +4,32 @@ import "time"
 
 type commandPacket struct {
 	Header  *HeaderCommand
-	Message message
+	Message message `type:"slice"`
+}
+
+// validPrefix check if c's prefix is valid
+func (c *commandPacket) validPrefix() bool {
+	if c.Header == nil {
+		return false
+	}
+	return c.Header.Prefix == PREFIX_COMMAND
+}
+
+// validSize check if c's size is valid, ignoring prefix & size field
+func (c *commandPacket) validSize() bool {
+	if c.Header == nil {
+		return false
+	}
+	return int(c.Header.Size) == getPacketSize(c)-3
+}
+
+// validCmdCode check if c's command code & subCode is registered
+func (c *commandPacket) validCmdCode() bool {
+	if c.Header == nil {
+		return false
+	}
+	_, err := getCmdByCode(int(c.Header.Code), int(c.Header.SubCode))
+	return err == nil
 }
 
 // command store essential command informations
@@ -34,7 +34,7 @@ func makeResponsePacket(vin int, cmd *command, msg message) *responsePacket {
 				Code:    cmd.code,
 				SubCode: cmd.subCode,
 			},
-			ResCode: resCodeOk,
+			ResCode: ResCodeOk,
 		},
 		Message: msg,
 	}
@@ -269,3 +269,57 @@ func makeReportPacket(version int, vin int, frame Frame) *ReportPacket {
 
 	return rp
 }
+
+// makeRandomData generate random value according to tag structure.
+// Float value is generated from its raw integer, so it is exactly encodable.
+func makeRandomData(tag tagger) interface{} {
+	tag = tag.normalize()
+
+	switch tag.Tipe {
+	case Struct_t:
+		data := make(PacketData, len(tag.Sub))
+		for _, sub := range tag.Sub {
+			data[sub.Name] = makeRandomData(sub)
+		}
+		return data
+	case Array_t:
+		items := make([]PacketData, tag.Len)
+		for i := range items {
+			items[i] = makeRandomData(tag.Sub[0]).(PacketData)
+		}
+		return items
+	case Time_t:
+		return time.Date(2000+rand.Intn(100), time.Month(1+rand.Intn(12)), 1+rand.Intn(28),
+			rand.Intn(24), rand.Intn(60), rand.Intn(60), 0, time.UTC)
+	case Boolean_t:
+		return rand.Intn(2) == 1
+	case Uint8_t:
+		return uint8(rand.Uint32())
+	case Uint16_t:
+		return uint16(rand.Uint32())
+	case Uint32_t:
+		return rand.Uint32()
+	case Uint64_t:
+		return rand.Uint64()
+	case Int8_t:
+		return int8(rand.Uint32())
+	case Int16_t:
+		return int16(rand.Uint32())
+	case Int32_t:
+		return int32(rand.Uint32())
+	case Int64_t:
+		return int64(rand.Uint64())
+	case Float_t:
+		var raw float64
+		switch tag.UnfactorType {
+		case Int8_t, Int16_t, Int32_t, Int64_t:
+			shift := uint(64 - 8*tag.Len)
+			raw = float64(int64(rand.Uint64()) >> shift)
+		default:
+			shift := uint(64 - 8*tag.Len)
+			raw = float64(rand.Uint64() >> shift)
+		}
+		return float32(raw * tag.Factor)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type ReportPacket struct {
@@ -19,10 +20,53 @@ func (r *ReportPacket) ValidPrefix() bool {
 	return r.Header.Prefix == PREFIX_REPORT
 }
 
-// ValidSize check if r's size is valid
+// size calculate r's size, ignoring prefix & size field
+func (r *ReportPacket) size() int {
+	return getPacketSize(&r.Header) - 3 + len(r.Payload)
+}
+
+// ValidSize check if r's declared size match the actual size,
+// and r's payload is not bigger than tag structure.
 func (r *ReportPacket) ValidSize(tag tagger) bool {
-	// "tagger size" + "vin" + "send datetime"
-	return int(r.Header.Size) == tag.getSize()+4+7
+	if int(r.Header.Size) != r.size() {
+		return false
+	}
+	return len(r.Payload) <= tag.getSize()
+}
+
+// validateReport validate incomming report packet against policy.
+// Lenient policy only accept what decodeReport accept,
+// strict policy also check size, vin, frame & send datetime.
+func (r *ReportPacket) validateReport(vin int, policy ReportPolicy) error {
+	if policy != ReportPolicyStrict {
+		return nil
+	}
+
+	tag := ReportPacketStructures[int(r.Header.Version)]
+	if !r.ValidSize(tag) {
+		return errInvalidSize
+	}
+	if int(r.Header.Vin) != vin {
+		return errInvalidVin
+	}
+
+	frame, isOk := r.GetValue("Report.Frame").(uint8)
+	if !isOk || frame < uint8(FrameSimple) || frame >= uint8(FrameLimit) {
+		return errInvalidFrame
+	}
+	size := tag.getSize()
+	if Frame(frame) == FrameSimple {
+		size = tag.getSectionsSize(reportSimpleSections)
+	}
+	if len(r.Payload) != size {
+		return errInvalidSize
+	}
+
+	sendDatetime, isOk := r.GetValue("Report.SendDatetime").(time.Time)
+	if !isOk || !validDatetime(sendDatetime) {
+		return errInvalidDatetime
+	}
+	return nil
 }
 
 // GetValue get report packet data by key
@@ -39,7 +83,7 @@ func (r *ReportPacket) GetValue(key string) interface{} {
 
 			if k_len > 2 && k[0] == '[' && k[k_len-1] == ']' {
 				idx, err = strconv.Atoi(k[1 : k_len-1])
-				if err != nil {
+				if err != nil || idx < 0 || idx >= len(data.([]PacketData)) {
 					return nil
 				}
 				data = data.([]PacketData)[idx]
@@ -95,6 +139,42 @@ func (r *ReportPacket) GetType(key string) VarDataType {
 	return VarDataType(result)
 }
 
+// ReportField is leaf field of report structure, Path is the key of GetValue.
+type ReportField struct {
+	Path string
+	Type VarDataType
+}
+
+// ReportFields flatten report structure of version into leaf fields, ordered as in packet.
+func ReportFields(version int) ([]ReportField, error) {
+	tag, isGot := ReportPacketStructures[version]
+	if !isGot {
+		return nil, errInvalidVersion
+	}
+	fields := []ReportField{}
+	flattenTag(tag, "", &fields)
+	return fields, nil
+}
+
+// flattenTag walk tag structure and append leaf fields.
+func flattenTag(tag tagger, path string, fields *[]ReportField) {
+	switch tag.Tipe {
+	case Struct_t:
+		for _, sub := range tag.Sub {
+			flattenTag(sub, joinPath(path, sub.Name), fields)
+		}
+	case Array_t:
+		if len(tag.Sub) == 0 {
+			return
+		}
+		for i := 0; i < tag.Len; i++ {
+			flattenTag(tag.Sub[0], joinPath(path, "["+strconv.Itoa(i)+"]"), fields)
+		}
+	default:
+		*fields = append(*fields, ReportField{Path: path, Type: tag.Tipe})
+	}
+}
+
 // GetBikeError get error from bike's report packet.
 // Return BIKE_NOERROR if no error detected
 // and return BIKE_ERROR_*** if any error.
@@ -0,0 +1,339 @@
+package sdk
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// reportSimpleSections store sections sent on simple frame report, in order.
+var reportSimpleSections = []string{"Report", "Vcu", "Eeprom", "Gps"}
+
+// ReportBuilder build valid report packet according to ReportPacketStructures.
+// It is useful to simulate VCU device on integration test.
+// Error is kept on the builder and returned by Build or Encode.
+type ReportBuilder struct {
+	version int
+	vin     int
+	frame   Frame
+	tag     tagger
+	data    PacketData
+	err     error
+}
+
+// NewReportBuilder create new report builder for specific version & VIN.
+// All fields are set to default value, and frame is set to FrameFull.
+// Examples :
+//
+//	b, err := sdk.NewReportBuilder(1, 354313).
+//		Set("Bms.SOC", 80).
+//		Set("Bms.Pack.[0].Voltage", 54.3).
+//		Encode()
+func NewReportBuilder(version, vin int) *ReportBuilder {
+	b := &ReportBuilder{
+		version: version,
+		vin:     vin,
+		frame:   FrameFull,
+	}
+
+	tag, isGot := ReportPacketStructures[version]
+	if !isGot {
+		b.err = errInvalidVersion
+		return b
+	}
+	b.tag = tag
+	b.data = makeDefaultData(tag).(PacketData)
+	return b
+}
+
+// Frame set report frame type.
+// Simple frame only contains reportSimpleSections.
+func (b *ReportBuilder) Frame(frame Frame) *ReportBuilder {
+	if b.err != nil {
+		return b
+	}
+	if frame < FrameSimple || frame >= FrameLimit {
+		b.err = errInputOutOfRange("frame")
+		return b
+	}
+	b.frame = frame
+	return b
+}
+
+// Set set field value by key, key format is same as ReportPacket.GetValue.
+// Value is validated against field type & range (after factor).
+func (b *ReportBuilder) Set(key string, value interface{}) *ReportBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	keys := strings.Split(key, ".")
+	tag, ok := b.tag.lookup(keys)
+	if !ok {
+		b.err = errFieldNotFound(key)
+		return b
+	}
+
+	v, ok := convertToTipe(tag.normalize(), value)
+	if !ok {
+		b.err = errInputOutOfRange(key)
+		return b
+	}
+
+	if !setDataValue(b.data, keys, v) {
+		b.err = errFieldNotFound(key)
+	}
+	return b
+}
+
+// Has check if field key is exist on builder's version.
+func (b *ReportBuilder) Has(key string) bool {
+	if b.data == nil {
+		return false
+	}
+	_, ok := b.tag.lookup(strings.Split(key, "."))
+	return ok
+}
+
+// Err return the first error of Frame or Set, it is also returned by Build.
+func (b *ReportBuilder) Err() error {
+	return b.err
+}
+
+// Build create report packet from builder.
+func (b *ReportBuilder) Build() (*ReportPacket, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	data := makeCopyData(b.data).(PacketData)
+	report := data["Report"].(PacketData)
+	report["Frame"] = uint8(b.frame)
+	if report["SendDatetime"].(time.Time).IsZero() {
+		report["SendDatetime"] = time.Now().UTC().Truncate(time.Second)
+	}
+	if report["LogDatetime"].(time.Time).IsZero() {
+		report["LogDatetime"] = report["SendDatetime"]
+	}
+
+	if b.frame == FrameSimple {
+		simple := make(PacketData, len(reportSimpleSections))
+		for _, section := range reportSimpleSections {
+			simple[section] = data[section]
+		}
+		data = simple
+	}
+
+	return &ReportPacket{
+		Header: Header{
+			Prefix:  PREFIX_REPORT,
+			Version: uint16(b.version),
+			Vin:     uint32(b.vin),
+		},
+		Data: data,
+	}, nil
+}
+
+// Encode build and encode report packet to bytes.
+func (b *ReportBuilder) Encode() ([]byte, error) {
+	rp, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return encodeReport(rp)
+}
+
+// lookup find sub tagger by keys, array index is written as [n].
+func (tag tagger) lookup(keys []string) (tagger, bool) {
+	for _, k := range keys {
+		if tag.Tipe == Array_t {
+			idx, ok := parseIndex(k)
+			if !ok || idx >= tag.Len || len(tag.Sub) == 0 {
+				return tag, false
+			}
+			tag = tag.Sub[0]
+			continue
+		}
+
+		found := false
+		for _, sub := range tag.Sub {
+			if sub.Name == k {
+				tag, found = sub, true
+				break
+			}
+		}
+		if !found {
+			return tag, false
+		}
+	}
+	return tag, tag.Tipe != Struct_t && tag.Tipe != Array_t
+}
+
+// parseIndex parse array index key, ex: [1]
+func parseIndex(k string) (int, bool) {
+	if len(k) < 3 || k[0] != '[' || k[len(k)-1] != ']' {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(k[1 : len(k)-1])
+	return idx, err == nil && idx >= 0
+}
+
+// setDataValue set value into nested data by keys.
+func setDataValue(data PacketData, keys []string, v interface{}) bool {
+	var cur interface{} = data
+	for i, k := range keys {
+		last := i == len(keys)-1
+
+		switch d := cur.(type) {
+		case PacketData:
+			if last {
+				d[k] = v
+				return true
+			}
+			cur = d[k]
+		case []PacketData:
+			idx, ok := parseIndex(k)
+			if !ok || idx >= len(d) || last {
+				return false
+			}
+			cur = d[idx]
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// convertToTipe convert value v into go type of tag's tipe.
+// It returns false if v has wrong type or out of range.
+func convertToTipe(tag tagger, v interface{}) (interface{}, bool) {
+	switch tag.Tipe {
+	case Boolean_t:
+		x, ok := v.(bool)
+		if !ok {
+			return nil, false
+		}
+		return x, true
+
+	case Time_t:
+		x, ok := v.(time.Time)
+		if !ok || x.Year() < 2000 || x.Year() > 2000+math.MaxUint8 {
+			return nil, false
+		}
+		return x.UTC().Truncate(time.Second), true
+
+	case Float_t:
+		x, ok := numberToFloat64(v)
+		if !ok {
+			return nil, false
+		}
+		rawTipe := tag.UnfactorType
+		if rawTipe == "" {
+			rawTipe = VarDataType("uint" + strconv.Itoa(8*tag.Len))
+		}
+		if !inRangeOf(rawTipe, math.Round(x/tag.Factor)) {
+			return nil, false
+		}
+		return float32(x), true
+
+	default:
+		x, ok := numberToFloat64(v)
+		if !ok || x != math.Trunc(x) || !inRangeOf(tag.Tipe, x) {
+			return nil, false
+		}
+
+		rv := setVarOfTypeData(tag.Tipe)
+		switch rv.Kind() {
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			rv.SetInt(int64(x))
+		default:
+			rv.SetUint(uint64(x))
+		}
+		return rv.Interface(), true
+	}
+}
+
+// numberToFloat64 convert any numeric value (including enum) to float64.
+func numberToFloat64(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return float64(rv.Int()), true
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// inRangeOf check if x is representable by integer typedata.
+func inRangeOf(typedata VarDataType, x float64) bool {
+	var min, max float64
+	switch typedata {
+	case Uint8_t:
+		min, max = 0, math.MaxUint8
+	case Uint16_t:
+		min, max = 0, math.MaxUint16
+	case Uint32_t:
+		min, max = 0, math.MaxUint32
+	case Uint64_t:
+		min, max = 0, math.MaxUint64
+	case Int8_t:
+		min, max = math.MinInt8, math.MaxInt8
+	case Int16_t:
+		min, max = math.MinInt16, math.MaxInt16
+	case Int32_t:
+		min, max = math.MinInt32, math.MaxInt32
+	case Int64_t:
+		min, max = math.MinInt64, math.MaxInt64
+	default:
+		return false
+	}
+	return x >= min && x <= max
+}
+
+// makeDefaultData generate zero value according to tag structure.
+func makeDefaultData(tag tagger) interface{} {
+	tag = tag.normalize()
+
+	switch tag.Tipe {
+	case Struct_t:
+		data := make(PacketData, len(tag.Sub))
+		for _, sub := range tag.Sub {
+			data[sub.Name] = makeDefaultData(sub)
+		}
+		return data
+	case Array_t:
+		items := make([]PacketData, tag.Len)
+		for i := range items {
+			items[i] = makeDefaultData(tag.Sub[0]).(PacketData)
+		}
+		return items
+	default:
+		rv, _ := createZeroElm(tag.Tipe)
+		return rv.Interface()
+	}
+}
+
+// makeCopyData deep copy nested data, so builder can be reused.
+func makeCopyData(v interface{}) interface{} {
+	switch d := v.(type) {
+	case PacketData:
+		data := make(PacketData, len(d))
+		for k, sub := range d {
+			data[k] = makeCopyData(sub)
+		}
+		return data
+	case []PacketData:
+		items := make([]PacketData, len(d))
+		for i, sub := range d {
+			items[i] = makeCopyData(sub).(PacketData)
+		}
+		return items
+	default:
+		return v
+	}
+}
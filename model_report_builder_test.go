@@ -0,0 +1,213 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestReportBuilderDefault(t *testing.T) {
+	for version := range ReportPacketStructures {
+		for _, frame := range []Frame{FrameSimple, FrameFull} {
+			t.Run(fmt.Sprint("version ", version, " ", frame), func(t *testing.T) {
+				b, err := NewReportBuilder(version, testVin).
+					Frame(frame).
+					Encode()
+				if err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+
+				rp, err := decodeReport(b)
+				if err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+				if err := rp.validateReport(testVin, ReportPolicyStrict); err != nil {
+					t.Fatal("want no error, got ", err)
+				}
+				if got := Frame(rp.GetValue("Report.Frame").(uint8)); got != frame {
+					t.Errorf("frame want %s, got %s", frame, got)
+				}
+				if _, got := rp.Data["Task"]; got != (frame == FrameFull) {
+					t.Errorf("task section want %v, got %v", frame == FrameFull, got)
+				}
+			})
+		}
+	}
+}
+
+func TestReportBuilderSet(t *testing.T) {
+	sendDatetime := time.Date(2021, 7, 29, 16, 51, 0, 0, time.UTC)
+
+	b, err := NewReportBuilder(1, testVin).
+		Set("Report.SendDatetime", sendDatetime).
+		Set("Vcu.State", BikeStateRun).
+		Set("Vcu.LockDown", true).
+		Set("Gps.Latitude", GPS_LAT_MIN).
+		Set("Bms.SOC", 80).
+		Set("Bms.Pack.[1].Voltage", 54.3).
+		Set("Hbar.Mode.Drive", ModeDriveSport).
+		Set("Mcu.RPM", -1200).
+		Encode()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	rp, err := decodeReport(b)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	testCases := []struct {
+		key  string
+		want interface{}
+	}{
+		{key: "Report.SendDatetime", want: sendDatetime},
+		{key: "Report.LogDatetime", want: sendDatetime},
+		{key: "Vcu.State", want: int8(BikeStateRun)},
+		{key: "Vcu.LockDown", want: true},
+		{key: "Bms.SOC", want: uint8(80)},
+		{key: "Bms.Pack.[0].Voltage", want: float32(0)},
+		{key: "Hbar.Mode.Drive", want: uint8(ModeDriveSport)},
+		{key: "Mcu.RPM", want: int16(-1200)},
+		{key: "Bms.Pack.[2].Voltage", want: nil},
+		{key: "Bms.Pack.[-1].Voltage", want: nil},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.key, func(t *testing.T) {
+			if got := rp.GetValue(tC.key); got != tC.want {
+				t.Errorf("want %v, got %v", tC.want, got)
+			}
+		})
+	}
+
+	if got := rp.GetValue("Bms.Pack.[1].Voltage").(float32); got < 54.29 || got > 54.31 {
+		t.Errorf("want %v, got %v", 54.3, got)
+	}
+	if got := rp.GetValue("Gps.Latitude").(float32); got != float32(GPS_LAT_MIN) {
+		t.Errorf("want %v, got %v", GPS_LAT_MIN, got)
+	}
+}
+
+func TestReportBuilderInvalid(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		version int
+		key     string
+		value   interface{}
+		frame   Frame
+		want    error
+	}{
+		{
+			desc:    "unknown version",
+			version: 99,
+			want:    errInvalidVersion,
+		},
+		{
+			desc:  "unknown field",
+			key:   "Bms.Unknown",
+			value: 1,
+			want:  errFieldNotFound("Bms.Unknown"),
+		},
+		{
+			desc:  "set whole section",
+			key:   "Bms",
+			value: 1,
+			want:  errFieldNotFound("Bms"),
+		},
+		{
+			desc:  "array index overflowed",
+			key:   "Bms.Pack.[2].SOC",
+			value: 1,
+			want:  errFieldNotFound("Bms.Pack.[2].SOC"),
+		},
+		{
+			desc:  "integer overflowed",
+			key:   "Bms.SOC",
+			value: 256,
+			want:  errInputOutOfRange("Bms.SOC"),
+		},
+		{
+			desc:  "negative unsigned integer",
+			key:   "Hbar.Trip.Odo",
+			value: -1,
+			want:  errInputOutOfRange("Hbar.Trip.Odo"),
+		},
+		{
+			desc:  "fraction on integer",
+			key:   "Bms.SOC",
+			value: 10.5,
+			want:  errInputOutOfRange("Bms.SOC"),
+		},
+		{
+			desc:  "float overflowed after factor",
+			key:   "Vcu.BatVoltage",
+			value: 18.0 * 256,
+			want:  errInputOutOfRange("Vcu.BatVoltage"),
+		},
+		{
+			desc:  "wrong type",
+			key:   "Vcu.LockDown",
+			value: "yes",
+			want:  errInputOutOfRange("Vcu.LockDown"),
+		},
+		{
+			desc:  "invalid frame",
+			frame: FrameLimit,
+			want:  errInputOutOfRange("frame"),
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			version := tC.version
+			if version == 0 {
+				version = 1
+			}
+			frame := tC.frame
+			if frame == 0 {
+				frame = FrameFull
+			}
+
+			b := NewReportBuilder(version, testVin).Frame(frame)
+			if tC.key != "" {
+				b.Set(tC.key, tC.value)
+			}
+
+			if err := b.Err(); err != tC.want {
+				t.Errorf("want %v, got %v", tC.want, err)
+			}
+			_, err := b.Encode()
+			if err != tC.want {
+				t.Errorf("want %v, got %v", tC.want, err)
+			}
+		})
+	}
+}
+
+func TestReportFields(t *testing.T) {
+	for version := range ReportPacketStructures {
+		t.Run(fmt.Sprint("version ", version), func(t *testing.T) {
+			fields, err := ReportFields(version)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+
+			rp, err := NewReportBuilder(version, testVin).Frame(FrameFull).Build()
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			for _, f := range fields {
+				if rp.GetValue(f.Path) == nil {
+					t.Errorf("%s want value, got nil", f.Path)
+				}
+				if got := rp.GetType(f.Path); got != f.Type {
+					t.Errorf("%s want %s, got %s", f.Path, f.Type, got)
+				}
+			}
+		})
+	}
+
+	if _, err := ReportFields(99); err != errInvalidVersion {
+		t.Errorf("want %s, got %v", errInvalidVersion, err)
+	}
+}
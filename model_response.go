@@ -1,7 +1,6 @@
 package sdk
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 )
@@ -57,7 +56,7 @@ func (r *responsePacket) validResCode() bool {
 	if r.Header == nil {
 		return false
 	}
-	for i := resCodeError; i < resCodeLimit; i++ {
+	for i := ResCodeError; i < ResCodeLimit; i++ {
 		if r.Header.ResCode == i {
 			return true
 		}
@@ -94,15 +93,16 @@ func (r *responsePacket) validateResponse(vin int, cmd *command) error {
 	if !r.belongsTo(cmd) {
 		return errInvalidCmdCode
 	}
-	if r.Header.ResCode == resCodeOk {
+	if r.Header.ResCode == ResCodeOk {
 		return nil
 	}
 
-	out := fmt.Sprint(r.Header.ResCode)
 	// check if message is not empty
 	if r.hasMessage() {
 		r.renderMessage()
-		out += fmt.Sprint(" ", string(r.Message))
 	}
-	return errors.New(out)
+	return errResponse{
+		code: r.Header.ResCode,
+		msg:  string(r.Message),
+	}
 }
@@ -0,0 +1,743 @@
+// Package mqtt5 implements MQTT 5 control packets and a small MQTT 5 client.
+//
+// Client implements mqtt.Client of paho (which speaks MQTT 3.1.1 only) and is
+// configured with the same mqtt.ClientOptions, so it replaces mqtt.NewClient:
+//
+//	c := mqtt5.NewClient(opts)
+//
+// Properties of outgoing message are set by publishing Payload,
+// properties of incoming message are read from Message:
+//
+//	c.Publish(topic, 1, false, mqtt5.Payload{
+//		Data:  b,
+//		Props: mqtt5.Properties{MessageExpiry: mqtt5.Uint32(10)},
+//	})
+//
+// Shared subscriptions are subscribed with filter of Share.
+// ReadPacket & WritePacket support MQTT 3.1.1 too, see package broker.
+package mqtt5
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/gorilla/websocket"
+)
+
+// SESSION_EXPIRY_MAX keep session forever, it is used when clean session is off.
+const SESSION_EXPIRY_MAX = 0xFFFFFFFF
+
+var (
+	ErrNotConnected       = errors.New("not connected")
+	ErrUnsupportedVersion = errors.New("unsupported protocol version")
+	ErrSessionLost        = errors.New("session lost")
+	// ErrUnsupportedOption is returned on Connect when NewClient got option of paho
+	// this client can't honour.
+	ErrUnsupportedOption = errors.New("unsupported option")
+)
+
+const (
+	disconnected uint32 = iota
+	connecting
+	reconnecting
+	connected
+)
+
+// Payload is payload of Client.Publish with properties.
+type Payload struct {
+	Data  []byte
+	Props Properties
+}
+
+// Client is MQTT 5 client.
+type Client struct {
+	opts   mqtt.ClientOptions
+	reader mqtt.ClientOptionsReader
+	routes *router
+	// err is unsupported option, returned on connect.
+	err error
+	// maxPacket is maximum size of received packet.
+	maxPacket int
+
+	mu       sync.Mutex
+	status   uint32
+	link     *link
+	stop     chan struct{}
+	inflight map[uint16]*pending
+	msgID    uint16
+	// received is qos 2 message id waiting for PUBREL.
+	received map[uint16]bool
+}
+
+// pending is packet waiting acknowledgement, publish is kept to be resent on reconnect.
+type pending struct {
+	t *token
+	p *Publish
+	// released is set once PUBREC is received, PUBREL is resent instead of publish.
+	released bool
+}
+
+// link is network connection of client, replaced on reconnect.
+type link struct {
+	conn      net.Conn
+	keepAlive time.Duration
+	maxPacket int
+	msgs      chan *Message
+	writeMu   sync.Mutex
+	done      chan struct{}
+	once      sync.Once
+}
+
+func (l *link) close() {
+	l.once.Do(func() {
+		close(l.done)
+		l.conn.Close()
+	})
+}
+
+// NewClient create client from paho options. ProtocolVersion must be 0 or 5,
+// Store & ResumeSubs are not supported (inflight messages are kept in memory),
+// otherwise Connect fail with ErrUnsupportedOption.
+// Order, MessageChannelDepth, WebsocketOptions and the handlers, credentials, timeouts,
+// TLS & will options are honoured, other options are ignored.
+func NewClient(o *mqtt.ClientOptions) *Client {
+	c := &Client{
+		opts:      *o,
+		reader:    mqtt.NewClient(o).OptionsReader(),
+		routes:    &router{},
+		maxPacket: DEFAULT_PACKET_SIZE,
+		inflight:  map[uint16]*pending{},
+		received:  map[uint16]bool{},
+	}
+	switch {
+	case o.ProtocolVersion != 0 && o.ProtocolVersion != uint(VERSION_5):
+		c.err = fmt.Errorf("%w: protocol version %d", ErrUnsupportedOption, o.ProtocolVersion)
+	case o.Store != nil:
+		c.err = fmt.Errorf("%w: store", ErrUnsupportedOption)
+	case o.ResumeSubs:
+		c.err = fmt.Errorf("%w: resume subs", ErrUnsupportedOption)
+	}
+	return c
+}
+
+// SetMaxPacketSize set maximum size of packet accepted from server, default DEFAULT_PACKET_SIZE.
+// It is sent on CONNECT, larger packet close the connection. Call it before Connect.
+func (c *Client) SetMaxPacketSize(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if size <= 0 {
+		size = DEFAULT_PACKET_SIZE
+	}
+	c.maxPacket = size
+}
+
+func (c *Client) packetMax() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.maxPacket
+}
+
+// IsConnected return true when connection is open, or being re-established automatically.
+func (c *Client) IsConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.status == connected || (c.opts.AutoReconnect && c.status == reconnecting)
+}
+
+// IsConnectionOpen return true when connection is open.
+func (c *Client) IsConnectionOpen() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.status == connected
+}
+
+// Connect open connection to the first server, it is not retried.
+func (c *Client) Connect() mqtt.Token {
+	t := newToken()
+
+	if c.err != nil {
+		t.complete(c.err, nil)
+		return t
+	}
+
+	c.mu.Lock()
+	if c.status != disconnected {
+		c.mu.Unlock()
+		t.complete(nil, nil)
+		return t
+	}
+	c.status = connecting
+	stop := make(chan struct{})
+	c.stop = stop
+	c.mu.Unlock()
+
+	go func() {
+		err := c.open(stop)
+		if err != nil {
+			c.mu.Lock()
+			if c.stop == stop && c.status == connecting {
+				c.status = disconnected
+			}
+			c.mu.Unlock()
+		}
+		t.complete(err, nil)
+	}()
+	return t
+}
+
+// Disconnect send DISCONNECT and close connection, quiesce is ignored.
+func (c *Client) Disconnect(quiesce uint) {
+	c.mu.Lock()
+	if c.status == disconnected {
+		c.mu.Unlock()
+		return
+	}
+	c.status = disconnected
+	close(c.stop)
+	l := c.link
+	c.link = nil
+	c.failInflight(ErrNotConnected, true)
+	c.mu.Unlock()
+
+	if l != nil {
+		c.send(l, &Disconnect{})
+		l.close()
+	}
+}
+
+// Publish send message, payload is Payload, []byte, string or bytes.Buffer.
+// QoS 1 & 2 message not acknowledged before connection loss is resent on reconnect
+// when the session is present, otherwise (ex: clean session) its token fail.
+func (c *Client) Publish(topic string, qos byte, retained bool, payload interface{}) mqtt.Token {
+	t := newToken()
+	p := &Publish{Topic: topic, Qos: qos, Retain: retained}
+	switch v := payload.(type) {
+	case Payload:
+		p.Payload, p.Props = v.Data, v.Props
+	case *Payload:
+		p.Payload, p.Props = v.Data, v.Props
+	case []byte:
+		p.Payload = v
+	case string:
+		p.Payload = []byte(v)
+	case bytes.Buffer:
+		p.Payload = v.Bytes()
+	case *bytes.Buffer:
+		p.Payload = v.Bytes()
+	default:
+		t.complete(fmt.Errorf("unknown payload type %T", payload), nil)
+		return t
+	}
+	if qos > 2 {
+		t.complete(fmt.Errorf("invalid qos %d", qos), nil)
+		return t
+	}
+
+	l, err := c.begin(t, p, &p.PacketID, qos > 0)
+	if err != nil {
+		t.complete(err, nil)
+		return t
+	}
+	if err := c.send(l, p); err != nil {
+		c.complete(p.PacketID, err, nil)
+		return t
+	}
+	if qos == 0 {
+		t.complete(nil, nil)
+	}
+	return t
+}
+
+// Subscribe subscribe topic filter, see SubscribeMultiple.
+func (c *Client) Subscribe(topic string, qos byte, callback mqtt.MessageHandler) mqtt.Token {
+	return c.SubscribeMultiple(map[string]byte{topic: qos}, callback)
+}
+
+// SubscribeMultiple subscribe topic filters, callback is routed before SUBACK is received.
+// Reason code of each filter is reported by Result of returned token.
+func (c *Client) SubscribeMultiple(filters map[string]byte, callback mqtt.MessageHandler) mqtt.Token {
+	t := newToken()
+	p := &Subscribe{}
+	for filter, qos := range filters {
+		p.Subscriptions = append(p.Subscriptions, Subscription{Filter: filter, Qos: qos})
+		t.filters = append(t.filters, filter)
+	}
+	sort.Slice(p.Subscriptions, func(i, j int) bool {
+		return p.Subscriptions[i].Filter < p.Subscriptions[j].Filter
+	})
+	sort.Strings(t.filters)
+
+	if callback != nil {
+		for _, filter := range t.filters {
+			c.routes.add(filter, callback)
+		}
+	}
+
+	l, err := c.begin(t, nil, &p.PacketID, true)
+	if err != nil {
+		t.complete(err, nil)
+		return t
+	}
+	if err := c.send(l, p); err != nil {
+		c.complete(p.PacketID, err, nil)
+	}
+	return t
+}
+
+// Unsubscribe unsubscribe topic filters and remove their routes.
+func (c *Client) Unsubscribe(topics ...string) mqtt.Token {
+	t := newToken()
+	for _, topic := range topics {
+		c.routes.remove(topic)
+	}
+
+	p := &Unsubscribe{Filters: topics}
+	l, err := c.begin(t, nil, &p.PacketID, true)
+	if err != nil {
+		t.complete(err, nil)
+		return t
+	}
+	if err := c.send(l, p); err != nil {
+		c.complete(p.PacketID, err, nil)
+	}
+	return t
+}
+
+// AddRoute route messages matched by topic filter to callback, without subscribing.
+func (c *Client) AddRoute(topic string, callback mqtt.MessageHandler) {
+	if callback != nil {
+		c.routes.add(topic, callback)
+	}
+}
+
+// OptionsReader return reader of options given to NewClient. It is built by paho,
+// which read ProtocolVersion 0 & 5 as 4 (MQTT 3.1.1).
+func (c *Client) OptionsReader() mqtt.ClientOptionsReader {
+	return c.reader
+}
+
+// begin return current connection, and register token waiting acknowledgement,
+// pub is publish to resend, nil for other packets.
+func (c *Client) begin(t *token, pub *Publish, id *uint16, ack bool) (*link, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.link == nil {
+		return nil, ErrNotConnected
+	}
+	if !ack {
+		return c.link, nil
+	}
+	for i := 0; i < 0xFFFF; i++ {
+		c.msgID++
+		if c.msgID == 0 {
+			c.msgID = 1
+		}
+		if _, used := c.inflight[c.msgID]; !used {
+			c.inflight[c.msgID] = &pending{t: t, p: pub}
+			*id = c.msgID
+			return c.link, nil
+		}
+	}
+	return nil, errors.New("no packet id available")
+}
+
+// complete finish token waiting acknowledgement of packet id.
+func (c *Client) complete(id uint16, err error, codes []byte) {
+	c.mu.Lock()
+	pd, ok := c.inflight[id]
+	delete(c.inflight, id)
+	c.mu.Unlock()
+
+	if ok {
+		pd.t.complete(err, codes)
+	}
+}
+
+// failInflight finish tokens waiting acknowledgement, publishes are kept unless all is set.
+// c.mu must be held.
+func (c *Client) failInflight(err error, all bool) {
+	for id, pd := range c.inflight {
+		if pd.p != nil && !all {
+			continue
+		}
+		pd.t.complete(err, nil)
+		delete(c.inflight, id)
+	}
+}
+
+// resumable return packets to resend on resumed session, in packet id order. c.mu must be held.
+func (c *Client) resumable() []Packet {
+	ids := make([]int, 0, len(c.inflight))
+	for id := range c.inflight {
+		ids = append(ids, int(id))
+	}
+	sort.Ints(ids)
+
+	packets := make([]Packet, 0, len(ids))
+	for _, id := range ids {
+		pd := c.inflight[uint16(id)]
+		if pd.released {
+			packets = append(packets, &Ack{Kind: PUBREL, PacketID: uint16(id)})
+			continue
+		}
+		dup := *pd.p
+		dup.Dup = true
+		packets = append(packets, &dup)
+	}
+	return packets
+}
+
+// open dial server and start session, it is called by Connect and reconnect.
+func (c *Client) open(stop chan struct{}) error {
+	if len(c.opts.Servers) == 0 {
+		return errors.New("no server")
+	}
+	server := c.opts.Servers[0]
+	tlsCfg := c.opts.TLSConfig
+	if c.opts.OnConnectAttempt != nil {
+		tlsCfg = c.opts.OnConnectAttempt(server, tlsCfg)
+	}
+
+	conn, err := dial(server, tlsCfg, &c.opts)
+	if err != nil {
+		return err
+	}
+	ack, err := c.handshake(conn)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	l := &link{
+		conn:      conn,
+		keepAlive: time.Duration(c.opts.KeepAlive) * time.Second,
+		maxPacket: c.packetMax(),
+		msgs:      make(chan *Message, c.opts.MessageChannelDepth),
+		done:      make(chan struct{}),
+	}
+	if ka := ack.Props.ServerKeepAlive; ka != nil {
+		l.keepAlive = time.Duration(*ka) * time.Second
+	}
+
+	c.mu.Lock()
+	select {
+	case <-stop:
+		c.mu.Unlock()
+		conn.Close()
+		return ErrNotConnected
+	default:
+	}
+	if !ack.SessionPresent {
+		c.received = map[uint16]bool{}
+		c.failInflight(ErrSessionLost, true)
+	}
+	resend := c.resumable()
+	c.link = l
+	c.status = connected
+	c.mu.Unlock()
+
+	go c.readLoop(l)
+	go c.dispatch(l)
+	if l.keepAlive > 0 {
+		go c.ping(l)
+	}
+	for _, p := range resend {
+		if c.send(l, p) != nil {
+			break
+		}
+	}
+	if c.opts.OnConnect != nil {
+		go c.opts.OnConnect(c)
+	}
+	return nil
+}
+
+// handshake send CONNECT and wait CONNACK.
+func (c *Client) handshake(conn net.Conn) (*Connack, error) {
+	if c.opts.ConnectTimeout > 0 {
+		conn.SetDeadline(time.Now().Add(c.opts.ConnectTimeout))
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	p := &Connect{
+		Version:    VERSION_5,
+		CleanStart: c.opts.CleanSession,
+		KeepAlive:  uint16(c.opts.KeepAlive),
+		ClientID:   c.opts.ClientID,
+		Username:   c.opts.Username,
+		Password:   []byte(c.opts.Password),
+	}
+	if c.opts.CredentialsProvider != nil {
+		user, pass := c.opts.CredentialsProvider()
+		p.Username, p.Password = user, []byte(pass)
+	}
+	if !c.opts.CleanSession {
+		p.Props.SessionExpiry = Uint32(SESSION_EXPIRY_MAX)
+	}
+	p.Props.MaximumPacketSize = Uint32(uint32(c.packetMax()))
+	if c.opts.WillEnabled {
+		p.Will = &Publish{
+			Topic:   c.opts.WillTopic,
+			Payload: c.opts.WillPayload,
+			Qos:     c.opts.WillQos,
+			Retain:  c.opts.WillRetained,
+		}
+	}
+	if err := WritePacket(conn, p, VERSION_5); err != nil {
+		return nil, err
+	}
+
+	cp, err := ReadPacket(conn, VERSION_5, c.packetMax())
+	if err != nil {
+		return nil, err
+	}
+	ack, ok := cp.(*Connack)
+	if !ok {
+		return nil, fmt.Errorf("want CONNACK, got packet type %d", cp.Type())
+	}
+	if ack.ReasonCode >= REASON_FAILURE {
+		return nil, reasonError("connect", ack.ReasonCode, ack.Props.ReasonString)
+	}
+	return ack, nil
+}
+
+func (c *Client) readLoop(l *link) {
+	for {
+		if l.keepAlive > 0 {
+			l.conn.SetReadDeadline(time.Now().Add(l.keepAlive * 3 / 2))
+		}
+		cp, err := ReadPacket(l.conn, VERSION_5, l.maxPacket)
+		if err != nil {
+			c.lost(l, err)
+			return
+		}
+
+		switch p := cp.(type) {
+		case *Publish:
+			select {
+			case l.msgs <- &Message{p: p}:
+			case <-l.done:
+				return
+			}
+		case *Ack:
+			c.acknowledged(l, p)
+		case *Suback:
+			c.complete(p.PacketID, nil, p.ReasonCodes)
+		case *Unsuback:
+			var err error
+			for _, code := range p.ReasonCodes {
+				if code >= REASON_FAILURE {
+					err = reasonError("unsubscribe", code, p.Props.ReasonString)
+				}
+			}
+			c.complete(p.PacketID, err, nil)
+		case *Pingresp:
+		case *Disconnect:
+			c.lost(l, reasonError("disconnected by server", p.ReasonCode, p.Props.ReasonString))
+			return
+		default:
+			c.lost(l, fmt.Errorf("unexpected packet type %d", cp.Type()))
+			return
+		}
+	}
+}
+
+// acknowledged handle PUBACK, PUBREC, PUBREL & PUBCOMP.
+func (c *Client) acknowledged(l *link, p *Ack) {
+	var err error
+	if p.ReasonCode >= REASON_FAILURE {
+		err = reasonError("publish", p.ReasonCode, p.Props.ReasonString)
+	}
+
+	switch p.Kind {
+	case PUBACK, PUBCOMP:
+		c.complete(p.PacketID, err, nil)
+	case PUBREC:
+		if err != nil {
+			c.complete(p.PacketID, err, nil)
+			return
+		}
+		c.mu.Lock()
+		if pd, ok := c.inflight[p.PacketID]; ok {
+			pd.released = true
+		}
+		c.mu.Unlock()
+		c.send(l, &Ack{Kind: PUBREL, PacketID: p.PacketID})
+	case PUBREL:
+		c.mu.Lock()
+		delete(c.received, p.PacketID)
+		c.mu.Unlock()
+		c.send(l, &Ack{Kind: PUBCOMP, PacketID: p.PacketID})
+	}
+}
+
+// dispatch call handlers of incoming messages, in received order if Order is set.
+func (c *Client) dispatch(l *link) {
+	for {
+		select {
+		case msg := <-l.msgs:
+			if c.opts.Order {
+				c.deliver(l, msg)
+			} else {
+				go c.deliver(l, msg)
+			}
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// deliver route message to handlers, then acknowledge it.
+func (c *Client) deliver(l *link, msg *Message) {
+	p := msg.p
+	switch p.Qos {
+	case 0:
+		c.routes.route(c, msg, c.opts.DefaultPublishHandler)
+	case 1:
+		c.routes.route(c, msg, c.opts.DefaultPublishHandler)
+		c.send(l, &Ack{Kind: PUBACK, PacketID: p.PacketID})
+	case 2:
+		c.mu.Lock()
+		dup := c.received[p.PacketID]
+		c.received[p.PacketID] = true
+		c.mu.Unlock()
+
+		if !dup {
+			c.routes.route(c, msg, c.opts.DefaultPublishHandler)
+		}
+		c.send(l, &Ack{Kind: PUBREC, PacketID: p.PacketID})
+	}
+}
+
+func (c *Client) ping(l *link) {
+	ticker := time.NewTicker(l.keepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.send(l, &Pingreq{})
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// send write packet, connection is closed on failure so it is detected as lost.
+func (c *Client) send(l *link, p Packet) error {
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+
+	if c.opts.WriteTimeout > 0 {
+		l.conn.SetWriteDeadline(time.Now().Add(c.opts.WriteTimeout))
+	}
+	err := WritePacket(l.conn, p, VERSION_5)
+	if err != nil {
+		l.conn.Close()
+	}
+	return err
+}
+
+// lost handle broken connection, and reconnect if AutoReconnect is set.
+func (c *Client) lost(l *link, err error) {
+	c.mu.Lock()
+	if c.link != l {
+		c.mu.Unlock()
+		return
+	}
+	c.link = nil
+	l.close()
+	retry := c.opts.AutoReconnect
+	// publish is resent on reconnect, unless the session is not resumed
+	c.failInflight(err, !retry || c.opts.CleanSession)
+	if retry {
+		c.status = reconnecting
+	} else {
+		c.status = disconnected
+	}
+	stop := c.stop
+	c.mu.Unlock()
+
+	go func() {
+		if c.opts.OnConnectionLost != nil {
+			c.opts.OnConnectionLost(c, err)
+		}
+		if retry {
+			c.reconnect(stop)
+		}
+	}()
+}
+
+// reconnect open connection with backoff until succeed or Disconnect is called.
+func (c *Client) reconnect(stop chan struct{}) {
+	wait := time.Second
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		if c.opts.OnReconnecting != nil {
+			c.opts.OnReconnecting(c, &c.opts)
+		}
+		if err := c.open(stop); err == nil {
+			return
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(wait):
+		}
+		if wait *= 2; c.opts.MaxReconnectInterval > 0 && wait > c.opts.MaxReconnectInterval {
+			wait = c.opts.MaxReconnectInterval
+		}
+	}
+}
+
+func reasonError(op string, code byte, reason string) error {
+	if reason != "" {
+		return fmt.Errorf("%s: reason 0x%02X %s", op, code, reason)
+	}
+	return fmt.Errorf("%s: reason 0x%02X", op, code)
+}
+
+// dial open network connection by scheme of server url.
+func dial(server *url.URL, tlsCfg *tls.Config, o *mqtt.ClientOptions) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: o.ConnectTimeout}
+	switch server.Scheme {
+	case "tcp", "mqtt":
+		return dialer.Dial("tcp", server.Host)
+	case "ssl", "tls", "mqtts", "mqtt+ssl", "tcps":
+		return tls.DialWithDialer(dialer, "tcp", server.Host, tlsCfg)
+	case "ws", "wss":
+		proxy := http.ProxyFromEnvironment
+		if o.WebsocketOptions != nil && o.WebsocketOptions.Proxy != nil {
+			proxy = o.WebsocketOptions.Proxy
+		}
+		d := websocket.Dialer{
+			Proxy:            proxy,
+			TLSClientConfig:  tlsCfg,
+			HandshakeTimeout: o.ConnectTimeout,
+			Subprotocols:     []string{"mqtt"},
+		}
+		ws, _, err := d.Dial(server.String(), o.HTTPHeaders)
+		if err != nil {
+			return nil, err
+		}
+		return NewWebsocketConn(ws), nil
+	}
+	return nil, fmt.Errorf("unknown protocol %s", server.Scheme)
+}
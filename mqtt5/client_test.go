@@ -0,0 +1,228 @@
+package mqtt5_test
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+)
+
+func TestClientInflightReconnect(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		qos     byte
+		clean   bool
+		present bool
+		// released acknowledge qos 2 publish with PUBREC before connection is lost
+		released bool
+		resent   mqtt5.Packet
+		want     error
+	}{
+		{
+			desc:    "qos 1 resent on resumed session",
+			qos:     1,
+			present: true,
+			resent:  &mqtt5.Publish{Dup: true, Qos: 1, Topic: "VCU/1/CMD", PacketID: 1, Payload: []byte("cmd")},
+		},
+		{
+			desc:     "qos 2 released on resumed session",
+			qos:      2,
+			present:  true,
+			released: true,
+			resent:   &mqtt5.Ack{Kind: mqtt5.PUBREL, PacketID: 1},
+		},
+		{
+			desc: "session lost",
+			qos:  1,
+			want: mqtt5.ErrSessionLost,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			ln := listen(t)
+			c := newTestClient(ln, tC.clean)
+			defer c.Disconnect(0)
+
+			conns := make(chan net.Conn, 2)
+			go acceptAll(ln, conns)
+
+			connected := c.Connect()
+			first := handshake(t, conns, false)
+			if !connected.WaitTimeout(time.Second) || connected.Error() != nil {
+				t.Fatal("want connected, got ", connected.Error())
+			}
+
+			token := c.Publish("VCU/1/CMD", tC.qos, false, []byte("cmd"))
+			readPacket(t, first)
+			if tC.released {
+				mqtt5.WritePacket(first, &mqtt5.Ack{Kind: mqtt5.PUBREC, PacketID: 1}, mqtt5.VERSION_5)
+				readPacket(t, first)
+			}
+			first.Close()
+
+			second := handshake(t, conns, tC.present)
+			if tC.resent != nil {
+				got := readPacket(t, second)
+				if !reflect.DeepEqual(tC.resent, got) {
+					t.Fatalf("want %+v, got %+v", tC.resent, got)
+				}
+				kind := mqtt5.PUBACK
+				if tC.released {
+					kind = mqtt5.PUBCOMP
+				}
+				mqtt5.WritePacket(second, &mqtt5.Ack{Kind: kind, PacketID: 1}, mqtt5.VERSION_5)
+			}
+
+			if !token.WaitTimeout(time.Second) {
+				t.Fatal("want publish completed")
+			}
+			if err := token.Error(); err != tC.want {
+				t.Errorf("want %v, got %v", tC.want, err)
+			}
+		})
+	}
+}
+
+func TestClientInflightCleanSession(t *testing.T) {
+	ln := listen(t)
+	c := newTestClient(ln, true)
+	defer c.Disconnect(0)
+
+	conns := make(chan net.Conn, 2)
+	go acceptAll(ln, conns)
+
+	connected := c.Connect()
+	first := handshake(t, conns, false)
+	if !connected.WaitTimeout(time.Second) || connected.Error() != nil {
+		t.Fatal("want connected, got ", connected.Error())
+	}
+
+	token := c.Publish("VCU/1/CMD", 1, false, []byte("cmd"))
+	readPacket(t, first)
+	first.Close()
+
+	// clean session is never resumed, so publish fail without waiting reconnect
+	if !token.WaitTimeout(time.Second) {
+		t.Fatal("want publish completed")
+	}
+	if token.Error() == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestClientOptions(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		modify      func(o *mqtt.ClientOptions)
+		unsupported bool
+	}{
+		{
+			desc:   "mqtt 5",
+			modify: func(o *mqtt.ClientOptions) { o.SetProtocolVersion(5) },
+		},
+		{
+			desc:        "mqtt 3.1.1",
+			modify:      func(o *mqtt.ClientOptions) { o.SetProtocolVersion(4) },
+			unsupported: true,
+		},
+		{
+			desc:        "store",
+			modify:      func(o *mqtt.ClientOptions) { o.SetStore(mqtt.NewMemoryStore()) },
+			unsupported: true,
+		},
+		{
+			desc:        "resume subs",
+			modify:      func(o *mqtt.ClientOptions) { o.SetResumeSubs(true) },
+			unsupported: true,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			opts := mqtt.NewClientOptions().SetClientID("sdk")
+			tC.modify(opts)
+			c := mqtt5.NewClient(opts)
+
+			// no server, so supported options fail later on dial
+			token := c.Connect()
+			token.Wait()
+			if got := errors.Is(token.Error(), mqtt5.ErrUnsupportedOption); got != tC.unsupported {
+				t.Errorf("want unsupported %v, got %v", tC.unsupported, token.Error())
+			}
+
+			reader := c.OptionsReader()
+			if got := reader.ClientID(); got != "sdk" {
+				t.Errorf("want %s, got %s", "sdk", got)
+			}
+		})
+	}
+}
+
+func listen(t *testing.T) net.Listener {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	return ln
+}
+
+func newTestClient(ln net.Listener, clean bool) *mqtt5.Client {
+	opts := mqtt.NewClientOptions().
+		AddBroker("tcp://" + ln.Addr().String()).
+		SetClientID("sdk").
+		SetCleanSession(clean).
+		SetAutoReconnect(true).
+		SetKeepAlive(0).
+		SetMaxReconnectInterval(10 * time.Millisecond)
+	return mqtt5.NewClient(opts)
+}
+
+func acceptAll(ln net.Listener, conns chan<- net.Conn) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			close(conns)
+			return
+		}
+		conns <- conn
+	}
+}
+
+// handshake read CONNECT of next connection and accept it.
+func handshake(t *testing.T, conns <-chan net.Conn, present bool) net.Conn {
+	t.Helper()
+
+	var conn net.Conn
+	select {
+	case conn = <-conns:
+	case <-time.After(time.Second):
+		t.Fatal("want connection")
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	if _, ok := readPacket(t, conn).(*mqtt5.Connect); !ok {
+		t.Fatal("want CONNECT")
+	}
+	ack := &mqtt5.Connack{SessionPresent: present}
+	if err := mqtt5.WritePacket(conn, ack, mqtt5.VERSION_5); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	return conn
+}
+
+func readPacket(t *testing.T, conn net.Conn) mqtt5.Packet {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	p, err := mqtt5.ReadPacket(conn, mqtt5.VERSION_5, 0)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	return p
+}
@@ -0,0 +1,639 @@
+package mqtt5
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Control packet types.
+const (
+	CONNECT     byte = 1
+	CONNACK     byte = 2
+	PUBLISH     byte = 3
+	PUBACK      byte = 4
+	PUBREC      byte = 5
+	PUBREL      byte = 6
+	PUBCOMP     byte = 7
+	SUBSCRIBE   byte = 8
+	SUBACK      byte = 9
+	UNSUBSCRIBE byte = 10
+	UNSUBACK    byte = 11
+	PINGREQ     byte = 12
+	PINGRESP    byte = 13
+	DISCONNECT  byte = 14
+)
+
+// Protocol levels, VERSION_31 is encoded like VERSION_311 except its protocol name.
+const (
+	VERSION_31  byte = 3
+	VERSION_311 byte = 4
+	VERSION_5   byte = 5
+)
+
+// PACKET_SIZE_MAX is maximum remaining length of packet.
+const PACKET_SIZE_MAX = 268435455
+
+// DEFAULT_PACKET_SIZE is default maximum size of read packet, including fixed header.
+const DEFAULT_PACKET_SIZE = 1 << 20
+
+// Reason codes used by this package, any code >= 0x80 is failure.
+const (
+	REASON_SUCCESS              byte = 0x00
+	REASON_FAILURE              byte = 0x80
+	REASON_UNSUPPORTED_VERSION  byte = 0x84
+	REASON_CLIENT_ID_INVALID    byte = 0x85
+	REASON_BAD_CREDENTIALS      byte = 0x86
+	REASON_NOT_AUTHORIZED       byte = 0x87
+	REASON_NO_MATCHING_SUBS     byte = 0x10
+	REASON_NO_SUBSCRIPTION      byte = 0x11
+	REASON_PACKET_TOO_LARGE     byte = 0x95
+	REASON_SHARED_SUBS_REJECTED byte = 0x9E
+)
+
+var errMalformed = errors.New("malformed packet")
+
+// Packet is MQTT control packet.
+type Packet interface {
+	Type() byte
+}
+
+// Connect is CONNECT packet, Version decide encoding of the whole connection.
+type Connect struct {
+	Version    byte
+	CleanStart bool
+	KeepAlive  uint16
+	ClientID   string
+	Username   string
+	Password   []byte
+	Will       *Publish
+	Props      Properties
+}
+
+// Connack is CONNACK packet, ReasonCode is return code on MQTT 3.1.1.
+type Connack struct {
+	SessionPresent bool
+	ReasonCode     byte
+	Props          Properties
+}
+
+// Publish is PUBLISH packet.
+type Publish struct {
+	Dup      bool
+	Qos      byte
+	Retain   bool
+	Topic    string
+	PacketID uint16
+	Props    Properties
+	Payload  []byte
+}
+
+// Ack is PUBACK, PUBREC, PUBREL or PUBCOMP packet, decided by Kind.
+type Ack struct {
+	Kind       byte
+	PacketID   uint16
+	ReasonCode byte
+	Props      Properties
+}
+
+// Subscription is topic filter of SUBSCRIBE packet.
+type Subscription struct {
+	Filter  string
+	Qos     byte
+	NoLocal bool
+}
+
+// Subscribe is SUBSCRIBE packet.
+type Subscribe struct {
+	PacketID      uint16
+	Subscriptions []Subscription
+	Props         Properties
+}
+
+// Suback is SUBACK packet, ReasonCodes is granted qos or failure of each filter.
+type Suback struct {
+	PacketID    uint16
+	ReasonCodes []byte
+	Props       Properties
+}
+
+// Unsubscribe is UNSUBSCRIBE packet.
+type Unsubscribe struct {
+	PacketID uint16
+	Filters  []string
+	Props    Properties
+}
+
+// Unsuback is UNSUBACK packet, ReasonCodes is empty on MQTT 3.1.1.
+type Unsuback struct {
+	PacketID    uint16
+	ReasonCodes []byte
+	Props       Properties
+}
+
+// Pingreq is PINGREQ packet.
+type Pingreq struct{}
+
+// Pingresp is PINGRESP packet.
+type Pingresp struct{}
+
+// Disconnect is DISCONNECT packet.
+type Disconnect struct {
+	ReasonCode byte
+	Props      Properties
+}
+
+func (*Connect) Type() byte     { return CONNECT }
+func (*Connack) Type() byte     { return CONNACK }
+func (*Publish) Type() byte     { return PUBLISH }
+func (p *Ack) Type() byte       { return p.Kind }
+func (*Subscribe) Type() byte   { return SUBSCRIBE }
+func (*Suback) Type() byte      { return SUBACK }
+func (*Unsubscribe) Type() byte { return UNSUBSCRIBE }
+func (*Unsuback) Type() byte    { return UNSUBACK }
+func (*Pingreq) Type() byte     { return PINGREQ }
+func (*Pingresp) Type() byte    { return PINGRESP }
+func (*Disconnect) Type() byte  { return DISCONNECT }
+
+// ErrPacketTooLarge is returned by ReadPacket when packet exceed maximum size,
+// the packet is not read so the connection should be closed.
+var ErrPacketTooLarge = errors.New("packet too large")
+
+// ReadPacket read one packet encoded in protocol version, packet larger than max bytes
+// is rejected before it is read, zero max use DEFAULT_PACKET_SIZE.
+// CONNECT is decoded with the version it carries.
+func ReadPacket(r io.Reader, version byte, max int) (Packet, error) {
+	if max <= 0 {
+		max = DEFAULT_PACKET_SIZE
+	}
+	var header [1]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	length, err := readVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if 1+len(appendVarint(nil, length))+length > max {
+		return nil, ErrPacketTooLarge
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+
+	d := &decoder{b: body, v5: version == VERSION_5}
+	kind, flags := header[0]>>4, header[0]&0x0F
+
+	var p Packet
+	switch kind {
+	case CONNECT:
+		p = d.connect()
+	case CONNACK:
+		p = d.connack()
+	case PUBLISH:
+		p = d.publish(flags)
+	case PUBACK, PUBREC, PUBREL, PUBCOMP:
+		p = d.ack(kind)
+	case SUBSCRIBE:
+		p = d.subscribe()
+	case SUBACK:
+		p = d.suback()
+	case UNSUBSCRIBE:
+		p = d.unsubscribe()
+	case UNSUBACK:
+		p = d.unsuback()
+	case PINGREQ:
+		p = &Pingreq{}
+	case PINGRESP:
+		p = &Pingresp{}
+	case DISCONNECT:
+		p = d.disconnect()
+	default:
+		return nil, fmt.Errorf("unknown packet type %d", kind)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return p, nil
+}
+
+// WritePacket write packet encoded in protocol version,
+// CONNECT is encoded with the version it carries.
+func WritePacket(w io.Writer, p Packet, version byte) error {
+	e := &encoder{v5: version == VERSION_5}
+	flags := byte(0)
+
+	switch p := p.(type) {
+	case *Connect:
+		e.v5 = p.Version == VERSION_5
+		e.connect(p)
+	case *Connack:
+		e.connack(p)
+	case *Publish:
+		flags = p.Qos << 1
+		if p.Dup {
+			flags |= 0x08
+		}
+		if p.Retain {
+			flags |= 0x01
+		}
+		e.publish(p)
+	case *Ack:
+		if p.Kind == PUBREL {
+			flags = 0x02
+		}
+		e.ack(p)
+	case *Subscribe:
+		flags = 0x02
+		e.subscribe(p)
+	case *Suback:
+		e.uint16(p.PacketID)
+		e.props(&p.Props)
+		e.buf.Write(p.ReasonCodes)
+	case *Unsubscribe:
+		flags = 0x02
+		e.uint16(p.PacketID)
+		e.props(&p.Props)
+		for _, f := range p.Filters {
+			e.string(f)
+		}
+	case *Unsuback:
+		e.uint16(p.PacketID)
+		if e.v5 {
+			e.props(&p.Props)
+			e.buf.Write(p.ReasonCodes)
+		}
+	case *Pingreq, *Pingresp:
+	case *Disconnect:
+		if e.v5 && (p.ReasonCode != REASON_SUCCESS || !p.Props.empty()) {
+			e.byte(p.ReasonCode)
+			e.props(&p.Props)
+		}
+	default:
+		return fmt.Errorf("unknown packet %T", p)
+	}
+
+	body := e.buf.Bytes()
+	if len(body) > PACKET_SIZE_MAX {
+		return errors.New("packet too large")
+	}
+	out := make([]byte, 0, len(body)+5)
+	out = append(out, p.Type()<<4|flags)
+	out = appendVarint(out, len(body))
+	out = append(out, body...)
+	_, err := w.Write(out)
+	return err
+}
+
+func readVarint(r io.Reader) (int, error) {
+	var n, shift int
+	var b [1]byte
+	for i := 0; i < 4; i++ {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return 0, err
+		}
+		n |= int(b[0]&0x7F) << shift
+		if b[0]&0x80 == 0 {
+			return n, nil
+		}
+		shift += 7
+	}
+	return 0, errMalformed
+}
+
+func appendVarint(b []byte, n int) []byte {
+	for {
+		digit := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			digit |= 0x80
+		}
+		b = append(b, digit)
+		if n == 0 {
+			return b
+		}
+	}
+}
+
+// decoder read fields of packet body, the first error is kept.
+type decoder struct {
+	b   []byte
+	v5  bool
+	err error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n > len(d.b) {
+		d.err = errMalformed
+		return nil
+	}
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b
+}
+
+func (d *decoder) byte() byte {
+	if b := d.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) uint16() uint16 {
+	if b := d.next(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (d *decoder) uint32() uint32 {
+	if b := d.next(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (d *decoder) varint() int {
+	var n, shift int
+	for i := 0; i < 4; i++ {
+		b := d.byte()
+		if d.err != nil {
+			return 0
+		}
+		n |= int(b&0x7F) << shift
+		if b&0x80 == 0 {
+			return n
+		}
+		shift += 7
+	}
+	d.err = errMalformed
+	return 0
+}
+
+func (d *decoder) binary() []byte {
+	n := int(d.uint16())
+	return append([]byte{}, d.next(n)...)
+}
+
+func (d *decoder) string() string {
+	return string(d.binary())
+}
+
+func (d *decoder) rest() []byte {
+	b := d.b
+	d.b = nil
+	return b
+}
+
+func (d *decoder) connect() *Connect {
+	p := &Connect{}
+	name := d.string()
+	p.Version = d.byte()
+	switch {
+	case name == "MQTT" && (p.Version == VERSION_311 || p.Version == VERSION_5):
+	case name == "MQIsdp" && p.Version == VERSION_31:
+	default:
+		if d.err == nil {
+			d.err = ErrUnsupportedVersion
+		}
+		return p
+	}
+	d.v5 = p.Version == VERSION_5
+
+	flags := d.byte()
+	p.CleanStart = flags&0x02 != 0
+	p.KeepAlive = d.uint16()
+	d.props(&p.Props)
+	p.ClientID = d.string()
+	if flags&0x04 != 0 {
+		p.Will = &Publish{
+			Qos:    flags >> 3 & 0x03,
+			Retain: flags&0x20 != 0,
+		}
+		d.props(&p.Will.Props)
+		p.Will.Topic = d.string()
+		p.Will.Payload = d.binary()
+	}
+	if flags&0x80 != 0 {
+		p.Username = d.string()
+	}
+	if flags&0x40 != 0 {
+		p.Password = d.binary()
+	}
+	return p
+}
+
+func (d *decoder) connack() *Connack {
+	p := &Connack{}
+	p.SessionPresent = d.byte()&0x01 != 0
+	p.ReasonCode = d.byte()
+	d.props(&p.Props)
+	return p
+}
+
+func (d *decoder) publish(flags byte) *Publish {
+	p := &Publish{
+		Dup:    flags&0x08 != 0,
+		Qos:    flags >> 1 & 0x03,
+		Retain: flags&0x01 != 0,
+	}
+	if p.Qos > 2 {
+		d.err = errMalformed
+		return p
+	}
+	p.Topic = d.string()
+	if p.Qos > 0 {
+		p.PacketID = d.uint16()
+	}
+	d.props(&p.Props)
+	p.Payload = append([]byte{}, d.rest()...)
+	return p
+}
+
+func (d *decoder) ack(kind byte) *Ack {
+	p := &Ack{Kind: kind}
+	p.PacketID = d.uint16()
+	if d.v5 && len(d.b) > 0 {
+		p.ReasonCode = d.byte()
+		if len(d.b) > 0 {
+			d.props(&p.Props)
+		}
+	}
+	return p
+}
+
+func (d *decoder) subscribe() *Subscribe {
+	p := &Subscribe{}
+	p.PacketID = d.uint16()
+	d.props(&p.Props)
+	for d.err == nil && len(d.b) > 0 {
+		filter := d.string()
+		opts := d.byte()
+		p.Subscriptions = append(p.Subscriptions, Subscription{
+			Filter:  filter,
+			Qos:     opts & 0x03,
+			NoLocal: d.v5 && opts&0x04 != 0,
+		})
+	}
+	if len(p.Subscriptions) == 0 && d.err == nil {
+		d.err = errMalformed
+	}
+	return p
+}
+
+func (d *decoder) suback() *Suback {
+	p := &Suback{}
+	p.PacketID = d.uint16()
+	d.props(&p.Props)
+	p.ReasonCodes = append([]byte{}, d.rest()...)
+	return p
+}
+
+func (d *decoder) unsubscribe() *Unsubscribe {
+	p := &Unsubscribe{}
+	p.PacketID = d.uint16()
+	d.props(&p.Props)
+	for d.err == nil && len(d.b) > 0 {
+		p.Filters = append(p.Filters, d.string())
+	}
+	if len(p.Filters) == 0 && d.err == nil {
+		d.err = errMalformed
+	}
+	return p
+}
+
+func (d *decoder) unsuback() *Unsuback {
+	p := &Unsuback{}
+	p.PacketID = d.uint16()
+	d.props(&p.Props)
+	p.ReasonCodes = append([]byte{}, d.rest()...)
+	return p
+}
+
+func (d *decoder) disconnect() *Disconnect {
+	p := &Disconnect{}
+	if d.v5 && len(d.b) > 0 {
+		p.ReasonCode = d.byte()
+		if len(d.b) > 0 {
+			d.props(&p.Props)
+		}
+	}
+	return p
+}
+
+// encoder write fields of packet body.
+type encoder struct {
+	buf bytes.Buffer
+	v5  bool
+}
+
+func (e *encoder) byte(b byte) {
+	e.buf.WriteByte(b)
+}
+
+func (e *encoder) uint16(n uint16) {
+	e.buf.Write([]byte{byte(n >> 8), byte(n)})
+}
+
+func (e *encoder) uint32(n uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], n)
+	e.buf.Write(b[:])
+}
+
+func (e *encoder) binary(b []byte) {
+	e.uint16(uint16(len(b)))
+	e.buf.Write(b)
+}
+
+func (e *encoder) string(s string) {
+	e.binary([]byte(s))
+}
+
+func (e *encoder) connect(p *Connect) {
+	switch p.Version {
+	case VERSION_31:
+		e.string("MQIsdp")
+	default:
+		e.string("MQTT")
+	}
+	e.byte(p.Version)
+
+	var flags byte
+	if p.CleanStart {
+		flags |= 0x02
+	}
+	if w := p.Will; w != nil {
+		flags |= 0x04 | w.Qos<<3
+		if w.Retain {
+			flags |= 0x20
+		}
+	}
+	if p.Username != "" {
+		flags |= 0x80
+	}
+	if len(p.Password) > 0 {
+		flags |= 0x40
+	}
+	e.byte(flags)
+	e.uint16(p.KeepAlive)
+	e.props(&p.Props)
+	e.string(p.ClientID)
+	if w := p.Will; w != nil {
+		e.props(&w.Props)
+		e.string(w.Topic)
+		e.binary(w.Payload)
+	}
+	if p.Username != "" {
+		e.string(p.Username)
+	}
+	if len(p.Password) > 0 {
+		e.binary(p.Password)
+	}
+}
+
+func (e *encoder) connack(p *Connack) {
+	if p.SessionPresent {
+		e.byte(0x01)
+	} else {
+		e.byte(0x00)
+	}
+	e.byte(p.ReasonCode)
+	e.props(&p.Props)
+}
+
+func (e *encoder) publish(p *Publish) {
+	e.string(p.Topic)
+	if p.Qos > 0 {
+		e.uint16(p.PacketID)
+	}
+	e.props(&p.Props)
+	e.buf.Write(p.Payload)
+}
+
+func (e *encoder) ack(p *Ack) {
+	e.uint16(p.PacketID)
+	if e.v5 && (p.ReasonCode != REASON_SUCCESS || !p.Props.empty()) {
+		e.byte(p.ReasonCode)
+		e.props(&p.Props)
+	}
+}
+
+func (e *encoder) subscribe(p *Subscribe) {
+	e.uint16(p.PacketID)
+	e.props(&p.Props)
+	for _, s := range p.Subscriptions {
+		e.string(s.Filter)
+		opts := s.Qos
+		if e.v5 && s.NoLocal {
+			opts |= 0x04
+		}
+		e.byte(opts)
+	}
+}
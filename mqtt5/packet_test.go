@@ -0,0 +1,205 @@
+package mqtt5_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+)
+
+func TestPacketRoundTrip(t *testing.T) {
+	props := mqtt5.Properties{
+		MessageExpiry:   mqtt5.Uint32(10),
+		ResponseTopic:   "VCU/1/RSP",
+		CorrelationData: []byte{1, 2, 3},
+		User:            []mqtt5.UserProperty{{Key: "k", Value: "v"}},
+	}
+
+	testCases := []struct {
+		desc    string
+		version byte
+		packet  mqtt5.Packet
+		want    mqtt5.Packet
+	}{
+		{
+			desc:    "connect v5",
+			version: mqtt5.VERSION_5,
+			packet: &mqtt5.Connect{
+				Version:   mqtt5.VERSION_5,
+				KeepAlive: 30,
+				ClientID:  "sdk",
+				Username:  "user",
+				Password:  []byte("pass"),
+				Will:      &mqtt5.Publish{Topic: "VCU/1/STS", Qos: 1, Retain: true, Payload: []byte("0"), Props: props},
+				Props: mqtt5.Properties{
+					SessionExpiry:     mqtt5.Uint32(mqtt5.SESSION_EXPIRY_MAX),
+					MaximumPacketSize: mqtt5.Uint32(mqtt5.DEFAULT_PACKET_SIZE),
+				},
+			},
+		},
+		{
+			desc:    "connect v311",
+			version: mqtt5.VERSION_311,
+			packet:  &mqtt5.Connect{Version: mqtt5.VERSION_311, CleanStart: true, ClientID: "sdk"},
+		},
+		{
+			desc:    "publish v5",
+			version: mqtt5.VERSION_5,
+			packet:  &mqtt5.Publish{Topic: "VCU/1/CMD", Qos: 2, PacketID: 7, Props: props, Payload: []byte("@C")},
+		},
+		{
+			desc:    "publish v311 drop properties",
+			version: mqtt5.VERSION_311,
+			packet:  &mqtt5.Publish{Topic: "VCU/1/CMD", Qos: 1, Retain: true, PacketID: 7, Props: props, Payload: []byte("@C")},
+			want:    &mqtt5.Publish{Topic: "VCU/1/CMD", Qos: 1, Retain: true, PacketID: 7, Payload: []byte("@C")},
+		},
+		{
+			desc:    "puback with reason",
+			version: mqtt5.VERSION_5,
+			packet:  &mqtt5.Ack{Kind: mqtt5.PUBACK, PacketID: 7, ReasonCode: mqtt5.REASON_NO_MATCHING_SUBS},
+		},
+		{
+			desc:    "pubrel",
+			version: mqtt5.VERSION_311,
+			packet:  &mqtt5.Ack{Kind: mqtt5.PUBREL, PacketID: 7},
+		},
+		{
+			desc:    "subscribe shared",
+			version: mqtt5.VERSION_5,
+			packet: &mqtt5.Subscribe{PacketID: 1, Subscriptions: []mqtt5.Subscription{
+				{Filter: mqtt5.Share("g", "VCU/+/RPT"), Qos: 1, NoLocal: true},
+			}},
+		},
+		{
+			desc:    "suback",
+			version: mqtt5.VERSION_5,
+			packet:  &mqtt5.Suback{PacketID: 1, ReasonCodes: []byte{1, mqtt5.REASON_FAILURE}},
+		},
+		{
+			desc:    "unsuback v311",
+			version: mqtt5.VERSION_311,
+			packet:  &mqtt5.Unsuback{PacketID: 1, ReasonCodes: []byte{0}},
+			want:    &mqtt5.Unsuback{PacketID: 1, ReasonCodes: []byte{}},
+		},
+		{
+			desc:    "disconnect",
+			version: mqtt5.VERSION_5,
+			packet:  &mqtt5.Disconnect{ReasonCode: 0x04},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := mqtt5.WritePacket(buf, tC.packet, tC.version); err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			got, err := mqtt5.ReadPacket(buf, tC.version, 0)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+
+			want := tC.want
+			if want == nil {
+				want = tC.packet
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("want %+v, got %+v", want, got)
+			}
+		})
+	}
+}
+
+func TestReadPacketMalformed(t *testing.T) {
+	testCases := []struct {
+		desc string
+		b    []byte
+	}{
+		{desc: "short body", b: []byte{0x30, 0x05, 0x00, 0x01}},
+		{desc: "unknown version", b: []byte{0x10, 0x07, 0x00, 0x04, 'M', 'Q', 'T', 'T', 0x06}},
+		{desc: "unknown property", b: []byte{0x30, 0x05, 0x00, 0x01, 'a', 0x01, 0x7F}},
+		{desc: "empty subscribe", b: []byte{0x82, 0x03, 0x00, 0x01, 0x00}},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			if _, err := mqtt5.ReadPacket(bytes.NewReader(tC.b), mqtt5.VERSION_5, 0); err == nil {
+				t.Error("want error, got none")
+			}
+		})
+	}
+}
+
+func TestReadPacketTooLarge(t *testing.T) {
+	testCases := []struct {
+		desc string
+		b    []byte
+		max  int
+		want error
+	}{
+		{
+			desc: "maximum remaining length",
+			b:    []byte{0x30, 0xFF, 0xFF, 0xFF, 0x7F},
+			want: mqtt5.ErrPacketTooLarge,
+		},
+		{
+			desc: "over custom maximum",
+			b:    []byte{0x30, 0x05, 0x00, 0x01, 'a', 0x00, 'b'},
+			max:  6,
+			want: mqtt5.ErrPacketTooLarge,
+		},
+		{
+			desc: "at custom maximum",
+			b:    []byte{0x30, 0x05, 0x00, 0x01, 'a', 0x00, 'b'},
+			max:  7,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			_, err := mqtt5.ReadPacket(bytes.NewReader(tC.b), mqtt5.VERSION_5, tC.max)
+			if err != tC.want {
+				t.Errorf("want %v, got %v", tC.want, err)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		filter string
+		topic  string
+		want   bool
+	}{
+		{"VCU/+/RPT", "VCU/354313/RPT", true},
+		{"$share/g/VCU/+/RPT", "VCU/354313/RPT", true},
+		{"$share/g/VCU/+/RPT", "VCU/354313/STS", false},
+		{"$share/g/#", "$SYS/uptime", false},
+		{"#", "$SYS/uptime", false},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.filter+" "+tC.topic, func(t *testing.T) {
+			if got := mqtt5.Match(tC.filter, tC.topic); got != tC.want {
+				t.Errorf("want %v, got %v", tC.want, got)
+			}
+		})
+	}
+}
+
+func TestSplitShare(t *testing.T) {
+	testCases := []struct {
+		filter string
+		group  string
+		topic  string
+	}{
+		{"$share/g/VCU/+/RPT", "g", "VCU/+/RPT"},
+		{"VCU/+/RPT", "", "VCU/+/RPT"},
+		{"$share/g", "", "$share/g"},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.filter, func(t *testing.T) {
+			group, topic := mqtt5.SplitShare(tC.filter)
+			if group != tC.group || topic != tC.topic {
+				t.Errorf("want %s %s, got %s %s", tC.group, tC.topic, group, topic)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package mqtt5
+
+import "fmt"
+
+// Property identifiers.
+const (
+	PROP_PAYLOAD_FORMAT         byte = 0x01
+	PROP_MESSAGE_EXPIRY         byte = 0x02
+	PROP_CONTENT_TYPE           byte = 0x03
+	PROP_RESPONSE_TOPIC         byte = 0x08
+	PROP_CORRELATION_DATA       byte = 0x09
+	PROP_SUBSCRIPTION_ID        byte = 0x0B
+	PROP_SESSION_EXPIRY         byte = 0x11
+	PROP_ASSIGNED_CLIENT_ID     byte = 0x12
+	PROP_SERVER_KEEP_ALIVE      byte = 0x13
+	PROP_AUTH_METHOD            byte = 0x15
+	PROP_AUTH_DATA              byte = 0x16
+	PROP_REQUEST_PROBLEM_INFO   byte = 0x17
+	PROP_WILL_DELAY             byte = 0x18
+	PROP_REQUEST_RESPONSE_INFO  byte = 0x19
+	PROP_RESPONSE_INFO          byte = 0x1A
+	PROP_SERVER_REFERENCE       byte = 0x1C
+	PROP_REASON_STRING          byte = 0x1F
+	PROP_RECEIVE_MAXIMUM        byte = 0x21
+	PROP_TOPIC_ALIAS_MAXIMUM    byte = 0x22
+	PROP_TOPIC_ALIAS            byte = 0x23
+	PROP_MAXIMUM_QOS            byte = 0x24
+	PROP_RETAIN_AVAILABLE       byte = 0x25
+	PROP_USER                   byte = 0x26
+	PROP_MAXIMUM_PACKET_SIZE    byte = 0x27
+	PROP_WILDCARD_SUB_AVAILABLE byte = 0x28
+	PROP_SUB_ID_AVAILABLE       byte = 0x29
+	PROP_SHARED_SUB_AVAILABLE   byte = 0x2A
+)
+
+// propKind is wire type of property value.
+type propKind uint8
+
+const (
+	propByte propKind = iota
+	propUint16
+	propUint32
+	propVarint
+	propString
+	propBinary
+	propPair
+)
+
+var propKinds = map[byte]propKind{
+	PROP_PAYLOAD_FORMAT:         propByte,
+	PROP_MESSAGE_EXPIRY:         propUint32,
+	PROP_CONTENT_TYPE:           propString,
+	PROP_RESPONSE_TOPIC:         propString,
+	PROP_CORRELATION_DATA:       propBinary,
+	PROP_SUBSCRIPTION_ID:        propVarint,
+	PROP_SESSION_EXPIRY:         propUint32,
+	PROP_ASSIGNED_CLIENT_ID:     propString,
+	PROP_SERVER_KEEP_ALIVE:      propUint16,
+	PROP_AUTH_METHOD:            propString,
+	PROP_AUTH_DATA:              propBinary,
+	PROP_REQUEST_PROBLEM_INFO:   propByte,
+	PROP_WILL_DELAY:             propUint32,
+	PROP_REQUEST_RESPONSE_INFO:  propByte,
+	PROP_RESPONSE_INFO:          propString,
+	PROP_SERVER_REFERENCE:       propString,
+	PROP_REASON_STRING:          propString,
+	PROP_RECEIVE_MAXIMUM:        propUint16,
+	PROP_TOPIC_ALIAS_MAXIMUM:    propUint16,
+	PROP_TOPIC_ALIAS:            propUint16,
+	PROP_MAXIMUM_QOS:            propByte,
+	PROP_RETAIN_AVAILABLE:       propByte,
+	PROP_USER:                   propPair,
+	PROP_MAXIMUM_PACKET_SIZE:    propUint32,
+	PROP_WILDCARD_SUB_AVAILABLE: propByte,
+	PROP_SUB_ID_AVAILABLE:       propByte,
+	PROP_SHARED_SUB_AVAILABLE:   propByte,
+}
+
+// UserProperty is key-value pair property, keys may repeat.
+type UserProperty struct {
+	Key   string
+	Value string
+}
+
+// Properties is MQTT 5 properties of a packet, nil/empty field is absent.
+// Properties not listed here are skipped when decoded.
+type Properties struct {
+	// MessageExpiry is lifetime of message in seconds.
+	MessageExpiry   *uint32
+	ContentType     string
+	ResponseTopic   string
+	CorrelationData []byte
+	// SessionExpiry is lifetime of session in seconds after disconnected.
+	SessionExpiry    *uint32
+	AssignedClientID string
+	ServerKeepAlive  *uint16
+	ReasonString     string
+	ReceiveMaximum   *uint16
+	MaximumQos       *byte
+	// MaximumPacketSize is the largest packet the sender accept.
+	MaximumPacketSize *uint32
+	User              []UserProperty
+}
+
+// Get return value of the first user property of key.
+func (p *Properties) Get(key string) (string, bool) {
+	for _, u := range p.User {
+		if u.Key == key {
+			return u.Value, true
+		}
+	}
+	return "", false
+}
+
+// Add append user property.
+func (p *Properties) Add(key, value string) {
+	p.User = append(p.User, UserProperty{Key: key, Value: value})
+}
+
+func (p *Properties) empty() bool {
+	return p.MessageExpiry == nil && p.ContentType == "" && p.ResponseTopic == "" &&
+		p.CorrelationData == nil && p.SessionExpiry == nil && p.AssignedClientID == "" &&
+		p.ServerKeepAlive == nil && p.ReasonString == "" && p.ReceiveMaximum == nil &&
+		p.MaximumQos == nil && p.MaximumPacketSize == nil && len(p.User) == 0
+}
+
+// Uint32 return pointer of n, for optional properties.
+func Uint32(n uint32) *uint32 {
+	return &n
+}
+
+// props decode properties, only on MQTT 5.
+func (d *decoder) props(p *Properties) {
+	if !d.v5 || d.err != nil {
+		return
+	}
+	n := d.varint()
+	body := d.next(n)
+	if d.err != nil {
+		return
+	}
+
+	pd := &decoder{b: body, v5: true}
+	for pd.err == nil && len(pd.b) > 0 {
+		id := pd.byte()
+		kind, ok := propKinds[id]
+		if !ok {
+			pd.err = fmt.Errorf("unknown property 0x%02X", id)
+			break
+		}
+
+		switch id {
+		case PROP_MESSAGE_EXPIRY:
+			p.MessageExpiry = Uint32(pd.uint32())
+		case PROP_CONTENT_TYPE:
+			p.ContentType = pd.string()
+		case PROP_RESPONSE_TOPIC:
+			p.ResponseTopic = pd.string()
+		case PROP_CORRELATION_DATA:
+			p.CorrelationData = pd.binary()
+		case PROP_SESSION_EXPIRY:
+			p.SessionExpiry = Uint32(pd.uint32())
+		case PROP_ASSIGNED_CLIENT_ID:
+			p.AssignedClientID = pd.string()
+		case PROP_SERVER_KEEP_ALIVE:
+			n := pd.uint16()
+			p.ServerKeepAlive = &n
+		case PROP_REASON_STRING:
+			p.ReasonString = pd.string()
+		case PROP_RECEIVE_MAXIMUM:
+			n := pd.uint16()
+			p.ReceiveMaximum = &n
+		case PROP_MAXIMUM_QOS:
+			n := pd.byte()
+			p.MaximumQos = &n
+		case PROP_MAXIMUM_PACKET_SIZE:
+			p.MaximumPacketSize = Uint32(pd.uint32())
+		case PROP_USER:
+			p.Add(pd.string(), pd.string())
+		default:
+			pd.skip(kind)
+		}
+	}
+	if pd.err != nil {
+		d.err = pd.err
+	}
+}
+
+func (d *decoder) skip(kind propKind) {
+	switch kind {
+	case propByte:
+		d.byte()
+	case propUint16:
+		d.uint16()
+	case propUint32:
+		d.uint32()
+	case propVarint:
+		d.varint()
+	case propString, propBinary:
+		d.binary()
+	case propPair:
+		d.binary()
+		d.binary()
+	}
+}
+
+// props encode properties with its length, only on MQTT 5.
+func (e *encoder) props(p *Properties) {
+	if !e.v5 {
+		return
+	}
+
+	pe := &encoder{v5: true}
+	if p.MessageExpiry != nil {
+		pe.byte(PROP_MESSAGE_EXPIRY)
+		pe.uint32(*p.MessageExpiry)
+	}
+	if p.ContentType != "" {
+		pe.byte(PROP_CONTENT_TYPE)
+		pe.string(p.ContentType)
+	}
+	if p.ResponseTopic != "" {
+		pe.byte(PROP_RESPONSE_TOPIC)
+		pe.string(p.ResponseTopic)
+	}
+	if p.CorrelationData != nil {
+		pe.byte(PROP_CORRELATION_DATA)
+		pe.binary(p.CorrelationData)
+	}
+	if p.SessionExpiry != nil {
+		pe.byte(PROP_SESSION_EXPIRY)
+		pe.uint32(*p.SessionExpiry)
+	}
+	if p.AssignedClientID != "" {
+		pe.byte(PROP_ASSIGNED_CLIENT_ID)
+		pe.string(p.AssignedClientID)
+	}
+	if p.ServerKeepAlive != nil {
+		pe.byte(PROP_SERVER_KEEP_ALIVE)
+		pe.uint16(*p.ServerKeepAlive)
+	}
+	if p.ReasonString != "" {
+		pe.byte(PROP_REASON_STRING)
+		pe.string(p.ReasonString)
+	}
+	if p.ReceiveMaximum != nil {
+		pe.byte(PROP_RECEIVE_MAXIMUM)
+		pe.uint16(*p.ReceiveMaximum)
+	}
+	if p.MaximumQos != nil {
+		pe.byte(PROP_MAXIMUM_QOS)
+		pe.byte(*p.MaximumQos)
+	}
+	if p.MaximumPacketSize != nil {
+		pe.byte(PROP_MAXIMUM_PACKET_SIZE)
+		pe.uint32(*p.MaximumPacketSize)
+	}
+	for _, u := range p.User {
+		pe.byte(PROP_USER)
+		pe.string(u.Key)
+		pe.string(u.Value)
+	}
+
+	e.buf.Write(appendVarint(nil, pe.buf.Len()))
+	e.buf.Write(pe.buf.Bytes())
+}
@@ -0,0 +1,152 @@
+package mqtt5
+
+import (
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// token implements mqtt.Token, subscribe token report result of each filter.
+type token struct {
+	done    chan struct{}
+	once    sync.Once
+	err     error
+	filters []string
+	result  map[string]byte
+}
+
+func newToken() *token {
+	return &token{done: make(chan struct{})}
+}
+
+func (t *token) Wait() bool {
+	<-t.done
+	return true
+}
+
+func (t *token) WaitTimeout(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-t.done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+func (t *token) Done() <-chan struct{} {
+	return t.done
+}
+
+func (t *token) Error() error {
+	select {
+	case <-t.done:
+		return t.err
+	default:
+		return nil
+	}
+}
+
+// Result return reason code (granted qos or failure) of each subscribed filter.
+func (t *token) Result() map[string]byte {
+	select {
+	case <-t.done:
+		return t.result
+	default:
+		return nil
+	}
+}
+
+// complete finish token once, codes is reason codes of SUBACK.
+func (t *token) complete(err error, codes []byte) {
+	t.once.Do(func() {
+		if err == nil && t.filters != nil {
+			if len(codes) != len(t.filters) {
+				err = errMalformed
+			} else {
+				t.result = make(map[string]byte, len(codes))
+				for i, filter := range t.filters {
+					t.result[filter] = codes[i]
+				}
+			}
+		}
+		t.err = err
+		close(t.done)
+	})
+}
+
+// Message is incoming message with its properties.
+type Message struct {
+	p *Publish
+}
+
+func (m *Message) Duplicate() bool   { return m.p.Dup }
+func (m *Message) Qos() byte         { return m.p.Qos }
+func (m *Message) Retained() bool    { return m.p.Retain }
+func (m *Message) Topic() string     { return m.p.Topic }
+func (m *Message) MessageID() uint16 { return m.p.PacketID }
+func (m *Message) Payload() []byte   { return m.p.Payload }
+
+// Ack is no-op, message is acknowledged after its handlers return.
+func (m *Message) Ack() {}
+
+// Properties return MQTT 5 properties of message.
+func (m *Message) Properties() Properties { return m.p.Props }
+
+type route struct {
+	filter  string
+	handler mqtt.MessageHandler
+}
+
+// router deliver message to handlers of matched filters.
+type router struct {
+	mu     sync.RWMutex
+	routes []route
+}
+
+func (r *router) add(filter string, handler mqtt.MessageHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.routes {
+		if r.routes[i].filter == filter {
+			r.routes[i].handler = handler
+			return
+		}
+	}
+	r.routes = append(r.routes, route{filter: filter, handler: handler})
+}
+
+func (r *router) remove(filter string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.routes {
+		if r.routes[i].filter == filter {
+			r.routes = append(r.routes[:i], r.routes[i+1:]...)
+			return
+		}
+	}
+}
+
+// route call handlers of matched filters, or fallback when none is matched.
+func (r *router) route(c mqtt.Client, msg *Message, fallback mqtt.MessageHandler) {
+	r.mu.RLock()
+	var handlers []mqtt.MessageHandler
+	for _, rt := range r.routes {
+		if Match(rt.filter, msg.Topic()) {
+			handlers = append(handlers, rt.handler)
+		}
+	}
+	r.mu.RUnlock()
+
+	if len(handlers) == 0 && fallback != nil {
+		fallback(c, msg)
+	}
+	for _, h := range handlers {
+		h(c, msg)
+	}
+}
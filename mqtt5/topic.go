@@ -0,0 +1,57 @@
+package mqtt5
+
+import "strings"
+
+// SHARE_PREFIX is prefix of shared subscription filter, "$share/{group}/{filter}".
+const SHARE_PREFIX = "$share/"
+
+// Share return shared subscription filter of group,
+// messages matched by it are delivered to one subscriber of the group.
+func Share(group, filter string) string {
+	return SHARE_PREFIX + group + "/" + filter
+}
+
+// SplitShare return group and topic filter of shared subscription filter,
+// group is empty when filter is not shared.
+func SplitShare(filter string) (group, topicFilter string) {
+	if !strings.HasPrefix(filter, SHARE_PREFIX) {
+		return "", filter
+	}
+	rest := filter[len(SHARE_PREFIX):]
+	i := strings.Index(rest, "/")
+	if i < 0 {
+		return "", filter
+	}
+	return rest[:i], rest[i+1:]
+}
+
+// ValidGroup report whether share name is legal.
+func ValidGroup(group string) bool {
+	return group != "" && !strings.ContainsAny(group, "/+#")
+}
+
+// Match report whether topic name is matched by topic filter (shared or not),
+// supporting single level (+) and multi level (#) wildcards.
+// Topic beginning with '$' never match filter starting with wildcard.
+func Match(filter, topic string) bool {
+	_, filter = SplitShare(filter)
+	if strings.HasPrefix(topic, "$") && !strings.HasPrefix(filter, "$") {
+		return false
+	}
+
+	fs := strings.Split(filter, "/")
+	ts := strings.Split(topic, "/")
+	for i, f := range fs {
+		switch {
+		case f == "#":
+			return true
+		case i >= len(ts):
+			return false
+		case f == "+":
+			continue
+		case f != ts[i]:
+			return false
+		}
+	}
+	return len(fs) == len(ts)
+}
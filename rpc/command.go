@@ -0,0 +1,361 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// commander is instance created by sdk.NewCommander.
+type commander interface {
+	GenInfo() (string, error)
+	GenLed(on bool) error
+	GenRtc(time time.Time) error
+	GenBikeState(state sdk.BikeState) error
+	GenLockDown(on bool) error
+	GenCanDebug(on bool) error
+	ReportFlush() error
+	ReportBlock(on bool) error
+	ReportInterval(dur time.Duration) error
+	ReportFrame(frame sdk.Frame) error
+	AudioBeep() error
+	FingerFetch() ([]int, error)
+	FingerAdd() (int, error)
+	FingerDel(id int) error
+	FingerRst() error
+	RemotePairing() error
+	RemoteSeat() error
+	RemoteAlarm() error
+	FotaRestart() error
+	FotaVcu() (string, error)
+	FotaHmi() (string, error)
+	NetSendUssd(ussd string) (string, error)
+	NetReadSms() (string, error)
+	HbarTripMeter(trip sdk.ModeTrip, km uint16) error
+	HbarDrive(drive sdk.ModeDrive) error
+	HbarTrip(trip sdk.ModeTrip) error
+	HbarAvg(avg sdk.ModeAvg) error
+	McuSpeedMax(kph uint8, userId uint8) error
+	McuSetDriveMode(mode sdk.ModeDrive, userId uint8) error
+	McuTemplates(ts []sdk.McuTemplate) error
+	ImuAntiThief(on bool) error
+	Destroy() error
+}
+
+// commander return cached commander of VIN, so command & response topic is subscribed once.
+// Commands to the same VIN are executed one at a time by the commander.
+func (s *Server) commander(vin uint32) (commander, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return nil, errServerClosed
+	}
+	if cmder, ok := s.commanders[int(vin)]; ok {
+		return cmder, nil
+	}
+	cmder, err := s.api.NewCommander(int(vin))
+	if err != nil {
+		return nil, statusOf(err)
+	}
+	s.commanders[int(vin)] = cmder
+	return cmder, nil
+}
+
+// exec run fn with commander of vin, error is converted to grpc status.
+// The device can't cancel command, so ctx only stop waiting for it.
+func (s *Server) exec(ctx context.Context, vin uint32, fn func(c commander) error) error {
+	cmder, err := s.commander(vin)
+	if err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(cmder)
+	}()
+
+	select {
+	case err := <-done:
+		return statusOf(err)
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// text run fn which reply string.
+func (s *Server) text(ctx context.Context, vin uint32, fn func(c commander) (string, error)) (*TextReply, error) {
+	res := &TextReply{}
+	err := s.exec(ctx, vin, func(c commander) (err error) {
+		res.Text, err = fn(c)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// command run fn which reply nothing.
+func (s *Server) command(ctx context.Context, vin uint32, fn func(c commander) error) (*CommandReply, error) {
+	if err := s.exec(ctx, vin, fn); err != nil {
+		return nil, err
+	}
+	return &CommandReply{}, nil
+}
+
+func (s *Server) GenInfo(ctx context.Context, req *VinRequest) (*TextReply, error) {
+	return s.text(ctx, req.Vin, func(c commander) (string, error) {
+		return c.GenInfo()
+	})
+}
+
+func (s *Server) GenLed(ctx context.Context, req *SwitchRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.GenLed(req.On)
+	})
+}
+
+func (s *Server) GenRtc(ctx context.Context, req *RtcRequest) (*CommandReply, error) {
+	if err := req.Time.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.GenRtc(req.Time.AsTime())
+	})
+}
+
+func (s *Server) GenBikeState(ctx context.Context, req *BikeStateRequest) (*CommandReply, error) {
+	if err := checkEnum(req.State, "state"); err != nil {
+		return nil, err
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.GenBikeState(sdk.BikeState(req.State))
+	})
+}
+
+func (s *Server) GenLockDown(ctx context.Context, req *SwitchRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.GenLockDown(req.On)
+	})
+}
+
+func (s *Server) GenCanDebug(ctx context.Context, req *SwitchRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.GenCanDebug(req.On)
+	})
+}
+
+func (s *Server) ReportFlush(ctx context.Context, req *VinRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.ReportFlush()
+	})
+}
+
+func (s *Server) ReportBlock(ctx context.Context, req *SwitchRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.ReportBlock(req.On)
+	})
+}
+
+func (s *Server) ReportInterval(ctx context.Context, req *IntervalRequest) (*CommandReply, error) {
+	if err := req.Interval.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.ReportInterval(req.Interval.AsDuration())
+	})
+}
+
+func (s *Server) ReportFrame(ctx context.Context, req *FrameRequest) (*CommandReply, error) {
+	if err := checkEnum(req.Frame, "frame"); err != nil {
+		return nil, err
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.ReportFrame(sdk.Frame(req.Frame))
+	})
+}
+
+func (s *Server) AudioBeep(ctx context.Context, req *VinRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.AudioBeep()
+	})
+}
+
+func (s *Server) FingerFetch(ctx context.Context, req *VinRequest) (*FingerFetchReply, error) {
+	res := &FingerFetchReply{}
+	err := s.exec(ctx, req.Vin, func(c commander) error {
+		ids, err := c.FingerFetch()
+		for _, id := range ids {
+			res.Ids = append(res.Ids, uint32(id))
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Server) FingerAdd(ctx context.Context, req *VinRequest) (*FingerAddReply, error) {
+	res := &FingerAddReply{}
+	err := s.exec(ctx, req.Vin, func(c commander) error {
+		id, err := c.FingerAdd()
+		res.Id = uint32(id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Server) FingerDel(ctx context.Context, req *FingerDelRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.FingerDel(int(req.Id))
+	})
+}
+
+func (s *Server) FingerRst(ctx context.Context, req *VinRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.FingerRst()
+	})
+}
+
+func (s *Server) RemotePairing(ctx context.Context, req *VinRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.RemotePairing()
+	})
+}
+
+func (s *Server) RemoteSeat(ctx context.Context, req *VinRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.RemoteSeat()
+	})
+}
+
+func (s *Server) RemoteAlarm(ctx context.Context, req *VinRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.RemoteAlarm()
+	})
+}
+
+func (s *Server) FotaRestart(ctx context.Context, req *VinRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.FotaRestart()
+	})
+}
+
+func (s *Server) FotaVcu(ctx context.Context, req *VinRequest) (*TextReply, error) {
+	return s.text(ctx, req.Vin, func(c commander) (string, error) {
+		return c.FotaVcu()
+	})
+}
+
+func (s *Server) FotaHmi(ctx context.Context, req *VinRequest) (*TextReply, error) {
+	return s.text(ctx, req.Vin, func(c commander) (string, error) {
+		return c.FotaHmi()
+	})
+}
+
+func (s *Server) NetSendUssd(ctx context.Context, req *UssdRequest) (*TextReply, error) {
+	return s.text(ctx, req.Vin, func(c commander) (string, error) {
+		return c.NetSendUssd(req.Ussd)
+	})
+}
+
+func (s *Server) NetReadSms(ctx context.Context, req *VinRequest) (*TextReply, error) {
+	return s.text(ctx, req.Vin, func(c commander) (string, error) {
+		return c.NetReadSms()
+	})
+}
+
+func (s *Server) HbarTripMeter(ctx context.Context, req *TripMeterRequest) (*CommandReply, error) {
+	if err := checkEnum(req.Trip, "trip"); err != nil {
+		return nil, err
+	}
+	if req.Km > math.MaxUint16 {
+		return nil, invalidArg("km")
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.HbarTripMeter(sdk.ModeTrip(req.Trip), uint16(req.Km))
+	})
+}
+
+func (s *Server) HbarDrive(ctx context.Context, req *DriveRequest) (*CommandReply, error) {
+	if err := checkEnum(req.Drive, "drive"); err != nil {
+		return nil, err
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.HbarDrive(sdk.ModeDrive(req.Drive))
+	})
+}
+
+func (s *Server) HbarTrip(ctx context.Context, req *TripRequest) (*CommandReply, error) {
+	if err := checkEnum(req.Trip, "trip"); err != nil {
+		return nil, err
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.HbarTrip(sdk.ModeTrip(req.Trip))
+	})
+}
+
+func (s *Server) HbarAvg(ctx context.Context, req *AvgRequest) (*CommandReply, error) {
+	if err := checkEnum(req.Avg, "avg"); err != nil {
+		return nil, err
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.HbarAvg(sdk.ModeAvg(req.Avg))
+	})
+}
+
+func (s *Server) McuSpeedMax(ctx context.Context, req *SpeedMaxRequest) (*CommandReply, error) {
+	if req.Kph > math.MaxUint8 {
+		return nil, invalidArg("kph")
+	}
+	if req.UserId > math.MaxUint8 {
+		return nil, invalidArg("user_id")
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.McuSpeedMax(uint8(req.Kph), uint8(req.UserId))
+	})
+}
+
+func (s *Server) McuSetDriveMode(ctx context.Context, req *SetDriveModeRequest) (*CommandReply, error) {
+	if err := checkEnum(req.Mode, "mode"); err != nil {
+		return nil, err
+	}
+	if req.UserId > math.MaxUint8 {
+		return nil, invalidArg("user_id")
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.McuSetDriveMode(sdk.ModeDrive(req.Mode), uint8(req.UserId))
+	})
+}
+
+func (s *Server) McuTemplates(ctx context.Context, req *TemplatesRequest) (*CommandReply, error) {
+	if len(req.Templates) != int(sdk.ModeDriveLimit) {
+		return nil, invalidArg("templates")
+	}
+	ts := make([]sdk.McuTemplate, len(req.Templates))
+	for i, t := range req.Templates {
+		if t.Discur > math.MaxUint8 || t.Torque > math.MaxUint8 {
+			return nil, invalidArg(fmt.Sprint("templates.", i))
+		}
+		ts[i] = sdk.McuTemplate{DisCur: uint8(t.Discur), Torque: uint8(t.Torque)}
+	}
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.McuTemplates(ts)
+	})
+}
+
+func (s *Server) ImuAntiThief(ctx context.Context, req *SwitchRequest) (*CommandReply, error) {
+	return s.command(ctx, req.Vin, func(c commander) error {
+		return c.ImuAntiThief(req.On)
+	})
+}
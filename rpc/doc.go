@@ -0,0 +1,17 @@
+// Package rpc expose the SDK as gRPC service, see vcu.proto.
+//
+// Reports are converted to Report message, a superset of all report versions.
+// Each command is unary RPC, while status & reports are server-streaming.
+//
+//	srv, _ := rpc.NewServer(&api)
+//	gs := grpc.NewServer()
+//	rpc.RegisterVcuServer(gs, srv)
+//	gs.Serve(lis)
+//
+// Generated code of vcu.proto is checked in, regenerate it with go generate,
+// which needs protoc v23.4 in PATH.
+package rpc
+
+//go:generate go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.31.0
+//go:generate go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.3.0
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative vcu.proto
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"fmt"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// checkEnum reject enum value which is not defined, as proto3 enum is open.
+func checkEnum(e protoreflect.Enum, name string) error {
+	if e.Descriptor().Values().ByNumber(e.Number()) == nil {
+		return invalidArg(name)
+	}
+	return nil
+}
+
+func invalidArg(name string) error {
+	return status.Error(codes.InvalidArgument, fmt.Sprintf("%s is out of range", name))
+}
+
+// statusOf convert command error to grpc status.
+func statusOf(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Unknown
+	switch {
+	case sdk.IsInputOutOfRange(err):
+		code = codes.InvalidArgument
+	case sdk.IsPacketTimeout(err):
+		code = codes.DeadlineExceeded
+	case sdk.IsClientDisconnected(err):
+		code = codes.Unavailable
+	}
+
+	if res, ok := sdk.ResponseCode(err); ok {
+		switch res {
+		case sdk.ResCodeInvalid:
+			code = codes.InvalidArgument
+		default:
+			// device refused command, ex: on wrong bike state
+			code = codes.FailedPrecondition
+		}
+	}
+	return status.Error(code, err.Error())
+}
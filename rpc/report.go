@@ -0,0 +1,208 @@
+package rpc
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewReport convert decoded report packet to Report message.
+// Data is matched to message field by name, ignoring case & underscore.
+func NewReport(rp *sdk.ReportPacket) *Report {
+	r := &Report{
+		Vin:     rp.Header.Vin,
+		Version: uint32(rp.Header.Version),
+	}
+	fill(r.ProtoReflect(), rp.Data)
+	return r
+}
+
+// fill set fields of m from data, unknown & mismatched data is skipped.
+func fill(m protoreflect.Message, data sdk.PacketData) {
+	fields := m.Descriptor().Fields()
+
+	for key, value := range data {
+		switch v := value.(type) {
+		case sdk.PacketData:
+			fd := lookupField(fields, key, false)
+			if fd == nil || fd.Message() == nil || isTimestamp(fd) {
+				continue
+			}
+			fill(m.Mutable(fd).Message(), v)
+		case []sdk.PacketData:
+			fd := lookupField(fields, key, true)
+			if fd == nil || fd.Message() == nil {
+				continue
+			}
+			list := m.Mutable(fd).List()
+			for _, d := range v {
+				el := list.NewElement()
+				fill(el.Message(), d)
+				list.Append(el)
+			}
+		default:
+			fd := lookupField(fields, key, false)
+			if fd == nil {
+				continue
+			}
+			if pv, ok := scalarOf(fd, v); ok {
+				m.Set(fd, pv)
+			}
+		}
+	}
+}
+
+// scalarOf convert decoded value to value of field fd.
+func scalarOf(fd protoreflect.FieldDescriptor, v interface{}) (protoreflect.Value, bool) {
+	if t, ok := v.(time.Time); ok {
+		if !isTimestamp(fd) {
+			return protoreflect.Value{}, false
+		}
+		return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if rv.Kind() == reflect.Bool {
+			return protoreflect.ValueOfBool(rv.Bool()), true
+		}
+	case protoreflect.FloatKind:
+		if rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64 {
+			return protoreflect.ValueOfFloat32(float32(rv.Float())), true
+		}
+	case protoreflect.Int32Kind:
+		if i, ok := intOf(rv); ok {
+			return protoreflect.ValueOfInt32(int32(i)), true
+		}
+	case protoreflect.Uint32Kind:
+		if i, ok := intOf(rv); ok {
+			return protoreflect.ValueOfUint32(uint32(i)), true
+		}
+	case protoreflect.EnumKind:
+		if i, ok := intOf(rv); ok {
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(i)), true
+		}
+	}
+	return protoreflect.Value{}, false
+}
+
+func intOf(rv reflect.Value) (int64, bool) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), true
+	}
+	return 0, false
+}
+
+// lookupField find field of report key, list field may have "_list" suffix.
+func lookupField(fields protoreflect.FieldDescriptors, key string, list bool) protoreflect.FieldDescriptor {
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() == list && matchField(fd, key) {
+			return fd
+		}
+	}
+	return nil
+}
+
+func matchField(fd protoreflect.FieldDescriptor, key string) bool {
+	name := normalize(string(fd.Name()))
+	key = normalize(key)
+	return name == key || (fd.IsList() && name == key+"list")
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+func isTimestamp(fd protoreflect.FieldDescriptor) bool {
+	md := fd.Message()
+	return md != nil && md.FullName() == (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+}
+
+// project clear fields of r which is not selected by fields,
+// field is GetValue path, ex: "Gps.Longitude", "Bms.Pack.[0].SOC".
+// Vin & version is always kept.
+func project(r *Report, fields []string) {
+	paths := [][]string{{"vin"}, {"version"}}
+	for _, f := range fields {
+		paths = append(paths, strings.Split(f, "."))
+	}
+	prune(r.ProtoReflect(), paths)
+}
+
+// prune clear fields of m not selected by paths, empty path select all.
+func prune(m protoreflect.Message, paths [][]string) {
+	for _, p := range paths {
+		if len(p) == 0 {
+			return
+		}
+	}
+
+	unselected := []protoreflect.FieldDescriptor{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub := [][]string{}
+		for _, p := range paths {
+			if matchField(fd, p[0]) {
+				sub = append(sub, p[1:])
+			}
+		}
+
+		switch {
+		case len(sub) == 0:
+			unselected = append(unselected, fd)
+		case fd.IsList() && fd.Message() != nil:
+			pruneList(v.List(), sub)
+		case fd.Message() != nil && !isTimestamp(fd):
+			prune(v.Message(), sub)
+		}
+		return true
+	})
+
+	for _, fd := range unselected {
+		m.Clear(fd)
+	}
+}
+
+// pruneList keep selected index of list, ex: "[0]", unselected one before it is emptied.
+func pruneList(list protoreflect.List, paths [][]string) {
+	for _, p := range paths {
+		if len(p) == 0 {
+			return
+		}
+	}
+
+	selected := map[int][][]string{}
+	last := -1
+	for _, p := range paths {
+		k := p[0]
+		if len(k) < 3 || k[0] != '[' || k[len(k)-1] != ']' {
+			continue
+		}
+		idx, err := strconv.Atoi(k[1 : len(k)-1])
+		if err != nil || idx < 0 || idx >= list.Len() {
+			continue
+		}
+		selected[idx] = append(selected[idx], p[1:])
+		if idx > last {
+			last = idx
+		}
+	}
+
+	list.Truncate(last + 1)
+	for i := 0; i < list.Len(); i++ {
+		if sub, ok := selected[i]; ok {
+			prune(list.Get(i).Message(), sub)
+			continue
+		}
+		list.Set(i, list.NewElement())
+	}
+}
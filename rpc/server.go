@@ -0,0 +1,206 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DEFAULT_BUFFER is number of events queued per stream before it is dropped.
+const DEFAULT_BUFFER = 64
+
+var (
+	errServerClosed = status.Error(codes.Unavailable, "server closed")
+	errSlowConsumer = status.Error(codes.ResourceExhausted, "slow consumer dropped")
+)
+
+// Server implement VcuServer backed by connected Sdk.
+type Server struct {
+	UnimplementedVcuServer
+
+	api        *sdk.Sdk
+	listener   *sdk.ListenerHandle
+	mutex      *sync.Mutex
+	commanders map[int]commander
+	watchers   map[*watcher]struct{}
+	closed     bool
+}
+
+// NewServer create server and listen to status & report of vins (all if empty).
+// The api should be connected.
+func NewServer(api *sdk.Sdk, vins ...int) (*Server, error) {
+	s := &Server{
+		api:        api,
+		mutex:      &sync.Mutex{},
+		commanders: map[int]commander{},
+		watchers:   map[*watcher]struct{}{},
+	}
+
+	listener := sdk.Listener{
+		StatusFunc: s.status,
+		ReportFunc: s.report,
+	}
+	h, err := api.AddListener(listener, vins...)
+	if err != nil {
+		return nil, err
+	}
+	s.listener = h
+	return s, nil
+}
+
+// Close remove listener, end all streams & destroy all commanders.
+func (s *Server) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for w := range s.watchers {
+		w.stop(errServerClosed)
+		delete(s.watchers, w)
+	}
+	for vin, cmder := range s.commanders {
+		cmder.Destroy()
+		delete(s.commanders, vin)
+	}
+	s.closed = true
+	return s.listener.Remove()
+}
+
+// WatchStatus stream status of requested vins.
+// Header is sent once subscribed, so client may wait for it before expecting events.
+func (s *Server) WatchStatus(req *WatchRequest, stream Vcu_WatchStatusServer) error {
+	return s.watch(req, stream, false, func(ev proto.Message) error {
+		return stream.Send(ev.(*StatusEvent))
+	})
+}
+
+// WatchReports stream reports of requested vins, projected to requested fields.
+// Header is sent once subscribed, so client may wait for it before expecting events.
+func (s *Server) WatchReports(req *WatchRequest, stream Vcu_WatchReportsServer) error {
+	return s.watch(req, stream, true, func(ev proto.Message) error {
+		r := ev.(*Report)
+		if len(req.Fields) > 0 {
+			r = proto.Clone(r).(*Report)
+			project(r, req.Fields)
+		}
+		return stream.Send(r)
+	})
+}
+
+// watch register watcher of request and send its events until stream or server is done.
+func (s *Server) watch(req *WatchRequest, stream grpc.ServerStream, reports bool, send func(ev proto.Message) error) error {
+	w, err := s.subscribe(req.Vins, reports)
+	if err != nil {
+		return err
+	}
+	defer s.unsubscribe(w)
+
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case ev := <-w.events:
+			if err := send(ev); err != nil {
+				return err
+			}
+		case <-w.done:
+			return w.err
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+func (s *Server) subscribe(vins []uint32, reports bool) (*watcher, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return nil, errServerClosed
+	}
+	w := newWatcher(vins, reports)
+	s.watchers[w] = struct{}{}
+	return w, nil
+}
+
+func (s *Server) unsubscribe(w *watcher) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.watchers, w)
+}
+
+func (s *Server) status(vin int, online bool) {
+	s.broadcast(vin, &StatusEvent{
+		Vin:    uint32(vin),
+		Online: online,
+		Time:   timestamppb.New(time.Now()),
+	})
+}
+
+func (s *Server) report(vin int, rp *sdk.ReportPacket) {
+	s.broadcast(vin, NewReport(rp))
+}
+
+// broadcast send event to each matched watcher without blocking,
+// watcher which can't keep up is dropped.
+func (s *Server) broadcast(vin int, ev proto.Message) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for w := range s.watchers {
+		if !w.match(vin, ev) {
+			continue
+		}
+		select {
+		case w.events <- ev:
+		default:
+			w.stop(errSlowConsumer)
+			delete(s.watchers, w)
+		}
+	}
+}
+
+// watcher is subscription of a stream, to either status or reports.
+type watcher struct {
+	vins    map[int]bool
+	reports bool
+	events  chan proto.Message
+	done    chan struct{}
+	err     error
+}
+
+func newWatcher(vins []uint32, reports bool) *watcher {
+	w := &watcher{
+		vins:    map[int]bool{},
+		reports: reports,
+		events:  make(chan proto.Message, DEFAULT_BUFFER),
+		done:    make(chan struct{}),
+	}
+	for _, vin := range vins {
+		w.vins[int(vin)] = true
+	}
+	return w
+}
+
+func (w *watcher) match(vin int, ev proto.Message) bool {
+	if _, isReport := ev.(*Report); isReport != w.reports {
+		return false
+	}
+	return len(w.vins) == 0 || w.vins[vin]
+}
+
+// stop end watcher with reason, called with server's mutex held.
+func (w *watcher) stop(reason error) {
+	w.err = reason
+	close(w.done)
+}
@@ -0,0 +1,306 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"github.com/garda-energi/gen.vcu.sdk/rpc"
+	"github.com/garda-energi/gen.vcu.sdk/sdktest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const testVin = 354313
+
+func TestServerCommand(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		expect func(f *sdktest.Fake)
+		call   func(ctx context.Context, c rpc.VcuClient) error
+		code   codes.Code
+	}{
+		{
+			desc: "ok",
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "HbarDrive").RespondOK()
+			},
+			call: func(ctx context.Context, c rpc.VcuClient) error {
+				_, err := c.HbarDrive(ctx, &rpc.DriveRequest{Vin: testVin, Drive: rpc.ModeDrive_MODE_DRIVE_SPORT})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			desc: "ok with text",
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "GenInfo").RespondWith(sdk.ResCodeOk, []byte("VCU v.664"))
+			},
+			call: func(ctx context.Context, c rpc.VcuClient) error {
+				res, err := c.GenInfo(ctx, &rpc.VinRequest{Vin: testVin})
+				if err == nil && res.Text != "VCU v.664" {
+					t.Errorf("want %s, got %s", "VCU v.664", res.Text)
+				}
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			desc:   "input out of range",
+			expect: func(f *sdktest.Fake) {},
+			call: func(ctx context.Context, c rpc.VcuClient) error {
+				_, err := c.McuSpeedMax(ctx, &rpc.SpeedMaxRequest{Vin: testVin, Kph: 250})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			desc:   "invalid enum",
+			expect: func(f *sdktest.Fake) {},
+			call: func(ctx context.Context, c rpc.VcuClient) error {
+				_, err := c.ReportFrame(ctx, &rpc.FrameRequest{Vin: testVin, Frame: rpc.Frame(99)})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			desc:   "incomplete templates",
+			expect: func(f *sdktest.Fake) {},
+			call: func(ctx context.Context, c rpc.VcuClient) error {
+				_, err := c.McuTemplates(ctx, &rpc.TemplatesRequest{
+					Vin:       testVin,
+					Templates: []*rpc.McuTemplate{{Discur: 50, Torque: 10}},
+				})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			desc: "packet timeout",
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "GenLed").NoAck()
+			},
+			call: func(ctx context.Context, c rpc.VcuClient) error {
+				_, err := c.GenLed(ctx, &rpc.SwitchRequest{Vin: testVin, On: true})
+				return err
+			},
+			code: codes.DeadlineExceeded,
+		},
+		{
+			desc: "response error",
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "GenLed").RespondError("State should = {1}")
+			},
+			call: func(ctx context.Context, c rpc.VcuClient) error {
+				_, err := c.GenLed(ctx, &rpc.SwitchRequest{Vin: testVin})
+				return err
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			desc: "response invalid",
+			expect: func(f *sdktest.Fake) {
+				f.ExpectCommand(testVin, "FingerDel").RespondWith(sdk.ResCodeInvalid, nil)
+			},
+			call: func(ctx context.Context, c rpc.VcuClient) error {
+				_, err := c.FingerDel(ctx, &rpc.FingerDelRequest{Vin: testVin, Id: 1})
+				return err
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			fake := sdktest.New()
+			client, _ := newServer(t, fake)
+			tC.expect(fake)
+
+			err := tC.call(context.Background(), client)
+			if code := status.Code(err); code != tC.code {
+				t.Fatalf("want %s, got %s", tC.code, err)
+			}
+			fake.AssertExpectations(t)
+		})
+	}
+}
+
+func TestServerWatchReports(t *testing.T) {
+	fake := sdktest.New()
+	client, _ := newServer(t, fake)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchReports(ctx, &rpc.WatchRequest{
+		Vins:   []uint32{testVin},
+		Fields: []string{"Bms.SOC", "Bms.Pack.[1].Voltage"},
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if _, err := stream.Header(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	other, _ := sdk.NewReportBuilder(1, testVin+1).Build()
+	if err := fake.EmitReport(testVin+1, other); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	rp, err := sdk.NewReportBuilder(1, testVin).
+		Set("Bms.SOC", 80).
+		Set("Bms.Pack.[1].Voltage", 60.5).
+		Set("Gps.Speed", 20).
+		Build()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if err := fake.EmitReport(testVin, rp); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	r, err := stream.Recv()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if r.Vin != testVin || r.Version != 1 {
+		t.Errorf("want %d v1, got %d v%d", testVin, r.Vin, r.Version)
+	}
+	if r.Bms.GetSoc() != 80 {
+		t.Errorf("want %d, got %d", 80, r.Bms.GetSoc())
+	}
+	if len(r.Bms.Pack) != 2 || r.Bms.Pack[0].Voltage != nil || r.Bms.Pack[1].GetVoltage() != 60.5 {
+		t.Errorf("want only pack 1 voltage, got %v", r.Bms.Pack)
+	}
+	if r.Gps != nil || r.Bms.Active != nil {
+		t.Errorf("want unselected fields cleared, got %v", r)
+	}
+}
+
+func TestServerWatchStatus(t *testing.T) {
+	fake := sdktest.New()
+	client, srv := newServer(t, fake)
+
+	stream, err := client.WatchStatus(context.Background(), &rpc.WatchRequest{})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if _, err := stream.Header(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	fake.SetOnline(testVin, true)
+	ev, err := stream.Recv()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if ev.Vin != testVin || !ev.Online {
+		t.Errorf("want %d online, got %v", testVin, ev)
+	}
+
+	srv.Close()
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Errorf("want %s, got %s", codes.Unavailable, err)
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	testCases := []struct {
+		version int
+		check   func(r *rpc.Report) bool
+	}{
+		{
+			version: 1,
+			check: func(r *rpc.Report) bool {
+				return len(r.Mcu.Template.DriveMode) == int(sdk.ModeDriveLimit) &&
+					r.Report.Frame == rpc.Frame_FRAME_FULL
+			},
+		},
+		{
+			version: 3,
+			check: func(r *rpc.Report) bool {
+				return len(r.Mcu.TemplateList) == 5 && r.Mcu.Template == nil && r.Mcu.IsOverSpeed != nil
+			},
+		},
+		{
+			version: 4,
+			check: func(r *rpc.Report) bool {
+				return len(r.Mcu.Setting) == 5 && r.Imu.IsFallen != nil
+			},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(sdk.ReportPacketStructures[tC.version].Name, func(t *testing.T) {
+			rp, err := sdk.NewReportBuilder(tC.version, testVin).
+				Frame(sdk.FrameFull).
+				Set("Vcu.State", int8(sdk.BikeStateRun)).
+				Set("Gps.Latitude", -7.25).
+				Build()
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+
+			r := rpc.NewReport(rp)
+			if r.Vcu.GetState() != rpc.BikeState_BIKE_STATE_RUN {
+				t.Errorf("want %s, got %s", rpc.BikeState_BIKE_STATE_RUN, r.Vcu.GetState())
+			}
+			if r.Gps.GetLatitude() != -7.25 {
+				t.Errorf("want %f, got %f", -7.25, r.Gps.GetLatitude())
+			}
+			if !r.Report.SendDatetime.AsTime().Equal(rp.GetValue("Report.SendDatetime").(time.Time)) {
+				t.Errorf("want %s, got %s", rp.GetValue("Report.SendDatetime"), r.Report.SendDatetime.AsTime())
+			}
+			if !tC.check(r) {
+				t.Errorf("want version specific fields, got %v", r)
+			}
+		})
+	}
+}
+
+func TestServiceCommands(t *testing.T) {
+	methods := rpc.File_vcu_proto.Services().ByName("Vcu").Methods()
+	for _, invoker := range sdk.Commands() {
+		if methods.ByName(protoreflect.Name(invoker)) == nil {
+			t.Errorf("want %s rpc, got nothing", invoker)
+		}
+	}
+}
+
+func newServer(t *testing.T, fake *sdktest.Fake) (rpc.VcuClient, *rpc.Server) {
+	t.Helper()
+
+	api := fake.Sdk()
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(api.Disconnect)
+
+	srv, err := rpc.NewServer(&api)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { srv.Close() })
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	rpc.RegisterVcuServer(gs, srv)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return rpc.NewVcuClient(conn), srv
+}
@@ -0,0 +1,5090 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.23.4
+// source: vcu.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Frame int32
+
+const (
+	Frame_FRAME_INVALID Frame = 0
+	Frame_FRAME_SIMPLE  Frame = 1
+	Frame_FRAME_FULL    Frame = 2
+)
+
+// Enum value maps for Frame.
+var (
+	Frame_name = map[int32]string{
+		0: "FRAME_INVALID",
+		1: "FRAME_SIMPLE",
+		2: "FRAME_FULL",
+	}
+	Frame_value = map[string]int32{
+		"FRAME_INVALID": 0,
+		"FRAME_SIMPLE":  1,
+		"FRAME_FULL":    2,
+	}
+)
+
+func (x Frame) Enum() *Frame {
+	p := new(Frame)
+	*p = x
+	return p
+}
+
+func (x Frame) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Frame) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcu_proto_enumTypes[0].Descriptor()
+}
+
+func (Frame) Type() protoreflect.EnumType {
+	return &file_vcu_proto_enumTypes[0]
+}
+
+func (x Frame) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Frame.Descriptor instead.
+func (Frame) EnumDescriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{0}
+}
+
+type BikeState int32
+
+const (
+	BikeState_BIKE_STATE_NORMAL  BikeState = 0
+	BikeState_BIKE_STATE_UNKNOWN BikeState = -3
+	BikeState_BIKE_STATE_LOST    BikeState = -2
+	BikeState_BIKE_STATE_BACKUP  BikeState = -1
+	BikeState_BIKE_STATE_STANDBY BikeState = 1
+	BikeState_BIKE_STATE_READY   BikeState = 2
+	BikeState_BIKE_STATE_RUN     BikeState = 3
+)
+
+// Enum value maps for BikeState.
+var (
+	BikeState_name = map[int32]string{
+		0:  "BIKE_STATE_NORMAL",
+		-3: "BIKE_STATE_UNKNOWN",
+		-2: "BIKE_STATE_LOST",
+		-1: "BIKE_STATE_BACKUP",
+		1:  "BIKE_STATE_STANDBY",
+		2:  "BIKE_STATE_READY",
+		3:  "BIKE_STATE_RUN",
+	}
+	BikeState_value = map[string]int32{
+		"BIKE_STATE_NORMAL":  0,
+		"BIKE_STATE_UNKNOWN": -3,
+		"BIKE_STATE_LOST":    -2,
+		"BIKE_STATE_BACKUP":  -1,
+		"BIKE_STATE_STANDBY": 1,
+		"BIKE_STATE_READY":   2,
+		"BIKE_STATE_RUN":     3,
+	}
+)
+
+func (x BikeState) Enum() *BikeState {
+	p := new(BikeState)
+	*p = x
+	return p
+}
+
+func (x BikeState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BikeState) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcu_proto_enumTypes[1].Descriptor()
+}
+
+func (BikeState) Type() protoreflect.EnumType {
+	return &file_vcu_proto_enumTypes[1]
+}
+
+func (x BikeState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BikeState.Descriptor instead.
+func (BikeState) EnumDescriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{1}
+}
+
+type ModeDrive int32
+
+const (
+	ModeDrive_MODE_DRIVE_ECONOMY  ModeDrive = 0
+	ModeDrive_MODE_DRIVE_STANDARD ModeDrive = 1
+	ModeDrive_MODE_DRIVE_SPORT    ModeDrive = 2
+)
+
+// Enum value maps for ModeDrive.
+var (
+	ModeDrive_name = map[int32]string{
+		0: "MODE_DRIVE_ECONOMY",
+		1: "MODE_DRIVE_STANDARD",
+		2: "MODE_DRIVE_SPORT",
+	}
+	ModeDrive_value = map[string]int32{
+		"MODE_DRIVE_ECONOMY":  0,
+		"MODE_DRIVE_STANDARD": 1,
+		"MODE_DRIVE_SPORT":    2,
+	}
+)
+
+func (x ModeDrive) Enum() *ModeDrive {
+	p := new(ModeDrive)
+	*p = x
+	return p
+}
+
+func (x ModeDrive) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModeDrive) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcu_proto_enumTypes[2].Descriptor()
+}
+
+func (ModeDrive) Type() protoreflect.EnumType {
+	return &file_vcu_proto_enumTypes[2]
+}
+
+func (x ModeDrive) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModeDrive.Descriptor instead.
+func (ModeDrive) EnumDescriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2}
+}
+
+type ModeTrip int32
+
+const (
+	ModeTrip_MODE_TRIP_ODO ModeTrip = 0
+	ModeTrip_MODE_TRIP_A   ModeTrip = 1
+	ModeTrip_MODE_TRIP_B   ModeTrip = 2
+)
+
+// Enum value maps for ModeTrip.
+var (
+	ModeTrip_name = map[int32]string{
+		0: "MODE_TRIP_ODO",
+		1: "MODE_TRIP_A",
+		2: "MODE_TRIP_B",
+	}
+	ModeTrip_value = map[string]int32{
+		"MODE_TRIP_ODO": 0,
+		"MODE_TRIP_A":   1,
+		"MODE_TRIP_B":   2,
+	}
+)
+
+func (x ModeTrip) Enum() *ModeTrip {
+	p := new(ModeTrip)
+	*p = x
+	return p
+}
+
+func (x ModeTrip) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModeTrip) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcu_proto_enumTypes[3].Descriptor()
+}
+
+func (ModeTrip) Type() protoreflect.EnumType {
+	return &file_vcu_proto_enumTypes[3]
+}
+
+func (x ModeTrip) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModeTrip.Descriptor instead.
+func (ModeTrip) EnumDescriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{3}
+}
+
+type ModeAvg int32
+
+const (
+	ModeAvg_MODE_AVG_RANGE      ModeAvg = 0
+	ModeAvg_MODE_AVG_EFFICIENCY ModeAvg = 1
+)
+
+// Enum value maps for ModeAvg.
+var (
+	ModeAvg_name = map[int32]string{
+		0: "MODE_AVG_RANGE",
+		1: "MODE_AVG_EFFICIENCY",
+	}
+	ModeAvg_value = map[string]int32{
+		"MODE_AVG_RANGE":      0,
+		"MODE_AVG_EFFICIENCY": 1,
+	}
+)
+
+func (x ModeAvg) Enum() *ModeAvg {
+	p := new(ModeAvg)
+	*p = x
+	return p
+}
+
+func (x ModeAvg) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModeAvg) Descriptor() protoreflect.EnumDescriptor {
+	return file_vcu_proto_enumTypes[4].Descriptor()
+}
+
+func (ModeAvg) Type() protoreflect.EnumType {
+	return &file_vcu_proto_enumTypes[4]
+}
+
+func (x ModeAvg) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModeAvg.Descriptor instead.
+func (ModeAvg) EnumDescriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{4}
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vins []uint32 `protobuf:"varint,1,rep,packed,name=vins,proto3" json:"vins,omitempty"`
+	// fields is GetValue path, ex: "Gps.Longitude", "Bms.Pack.[0].SOC".
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchRequest) GetVins() []uint32 {
+	if x != nil {
+		return x.Vins
+	}
+	return nil
+}
+
+func (x *WatchRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type StatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin    uint32                 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Online bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *StatusEvent) Reset() {
+	*x = StatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEvent) ProtoMessage() {}
+
+func (x *StatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEvent.ProtoReflect.Descriptor instead.
+func (*StatusEvent) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatusEvent) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *StatusEvent) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *StatusEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Report is superset of all report versions, field absent in the version
+// or in simple frame is unset. Field name match the report path,
+// list field with "_list" suffix is used when path is a list in some version.
+type Report struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin     uint32         `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Version uint32         `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Report  *Report_Meta   `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	Vcu     *Report_Vcu    `protobuf:"bytes,4,opt,name=vcu,proto3" json:"vcu,omitempty"`
+	Eeprom  *Report_Eeprom `protobuf:"bytes,5,opt,name=eeprom,proto3" json:"eeprom,omitempty"`
+	Gps     *Report_Gps    `protobuf:"bytes,6,opt,name=gps,proto3" json:"gps,omitempty"`
+	Hbar    *Report_Hbar   `protobuf:"bytes,7,opt,name=hbar,proto3" json:"hbar,omitempty"`
+	Net     *Report_Net    `protobuf:"bytes,8,opt,name=net,proto3" json:"net,omitempty"`
+	Imu     *Report_Imu    `protobuf:"bytes,9,opt,name=imu,proto3" json:"imu,omitempty"`
+	Remote  *Report_Remote `protobuf:"bytes,10,opt,name=remote,proto3" json:"remote,omitempty"`
+	Finger  *Report_Finger `protobuf:"bytes,11,opt,name=finger,proto3" json:"finger,omitempty"`
+	Audio   *Report_Audio  `protobuf:"bytes,12,opt,name=audio,proto3" json:"audio,omitempty"`
+	Hmi     *Report_Hmi    `protobuf:"bytes,13,opt,name=hmi,proto3" json:"hmi,omitempty"`
+	Bms     *Report_Bms    `protobuf:"bytes,14,opt,name=bms,proto3" json:"bms,omitempty"`
+	Mcu     *Report_Mcu    `protobuf:"bytes,15,opt,name=mcu,proto3" json:"mcu,omitempty"`
+	Task    *Report_Task   `protobuf:"bytes,16,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Report) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *Report) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Report) GetReport() *Report_Meta {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *Report) GetVcu() *Report_Vcu {
+	if x != nil {
+		return x.Vcu
+	}
+	return nil
+}
+
+func (x *Report) GetEeprom() *Report_Eeprom {
+	if x != nil {
+		return x.Eeprom
+	}
+	return nil
+}
+
+func (x *Report) GetGps() *Report_Gps {
+	if x != nil {
+		return x.Gps
+	}
+	return nil
+}
+
+func (x *Report) GetHbar() *Report_Hbar {
+	if x != nil {
+		return x.Hbar
+	}
+	return nil
+}
+
+func (x *Report) GetNet() *Report_Net {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *Report) GetImu() *Report_Imu {
+	if x != nil {
+		return x.Imu
+	}
+	return nil
+}
+
+func (x *Report) GetRemote() *Report_Remote {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+func (x *Report) GetFinger() *Report_Finger {
+	if x != nil {
+		return x.Finger
+	}
+	return nil
+}
+
+func (x *Report) GetAudio() *Report_Audio {
+	if x != nil {
+		return x.Audio
+	}
+	return nil
+}
+
+func (x *Report) GetHmi() *Report_Hmi {
+	if x != nil {
+		return x.Hmi
+	}
+	return nil
+}
+
+func (x *Report) GetBms() *Report_Bms {
+	if x != nil {
+		return x.Bms
+	}
+	return nil
+}
+
+func (x *Report) GetMcu() *Report_Mcu {
+	if x != nil {
+		return x.Mcu
+	}
+	return nil
+}
+
+func (x *Report) GetTask() *Report_Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type VinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin uint32 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+}
+
+func (x *VinRequest) Reset() {
+	*x = VinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VinRequest) ProtoMessage() {}
+
+func (x *VinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VinRequest.ProtoReflect.Descriptor instead.
+func (*VinRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VinRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+type SwitchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin uint32 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	On  bool   `protobuf:"varint,2,opt,name=on,proto3" json:"on,omitempty"`
+}
+
+func (x *SwitchRequest) Reset() {
+	*x = SwitchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchRequest) ProtoMessage() {}
+
+func (x *SwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchRequest.ProtoReflect.Descriptor instead.
+func (*SwitchRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SwitchRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *SwitchRequest) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+type RtcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin  uint32                 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *RtcRequest) Reset() {
+	*x = RtcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RtcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RtcRequest) ProtoMessage() {}
+
+func (x *RtcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RtcRequest.ProtoReflect.Descriptor instead.
+func (*RtcRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RtcRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *RtcRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type BikeStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin   uint32    `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	State BikeState `protobuf:"varint,2,opt,name=state,proto3,enum=vcu.BikeState" json:"state,omitempty"`
+}
+
+func (x *BikeStateRequest) Reset() {
+	*x = BikeStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BikeStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BikeStateRequest) ProtoMessage() {}
+
+func (x *BikeStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BikeStateRequest.ProtoReflect.Descriptor instead.
+func (*BikeStateRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BikeStateRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *BikeStateRequest) GetState() BikeState {
+	if x != nil {
+		return x.State
+	}
+	return BikeState_BIKE_STATE_NORMAL
+}
+
+type IntervalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin      uint32               `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *IntervalRequest) Reset() {
+	*x = IntervalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntervalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntervalRequest) ProtoMessage() {}
+
+func (x *IntervalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntervalRequest.ProtoReflect.Descriptor instead.
+func (*IntervalRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IntervalRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *IntervalRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type FrameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin   uint32 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Frame Frame  `protobuf:"varint,2,opt,name=frame,proto3,enum=vcu.Frame" json:"frame,omitempty"`
+}
+
+func (x *FrameRequest) Reset() {
+	*x = FrameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameRequest) ProtoMessage() {}
+
+func (x *FrameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameRequest.ProtoReflect.Descriptor instead.
+func (*FrameRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FrameRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *FrameRequest) GetFrame() Frame {
+	if x != nil {
+		return x.Frame
+	}
+	return Frame_FRAME_INVALID
+}
+
+type FingerDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin uint32 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Id  uint32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FingerDelRequest) Reset() {
+	*x = FingerDelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FingerDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FingerDelRequest) ProtoMessage() {}
+
+func (x *FingerDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FingerDelRequest.ProtoReflect.Descriptor instead.
+func (*FingerDelRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FingerDelRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *FingerDelRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UssdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin  uint32 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Ussd string `protobuf:"bytes,2,opt,name=ussd,proto3" json:"ussd,omitempty"`
+}
+
+func (x *UssdRequest) Reset() {
+	*x = UssdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UssdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UssdRequest) ProtoMessage() {}
+
+func (x *UssdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UssdRequest.ProtoReflect.Descriptor instead.
+func (*UssdRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UssdRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *UssdRequest) GetUssd() string {
+	if x != nil {
+		return x.Ussd
+	}
+	return ""
+}
+
+type TripMeterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin  uint32   `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Trip ModeTrip `protobuf:"varint,2,opt,name=trip,proto3,enum=vcu.ModeTrip" json:"trip,omitempty"`
+	Km   uint32   `protobuf:"varint,3,opt,name=km,proto3" json:"km,omitempty"`
+}
+
+func (x *TripMeterRequest) Reset() {
+	*x = TripMeterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripMeterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripMeterRequest) ProtoMessage() {}
+
+func (x *TripMeterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripMeterRequest.ProtoReflect.Descriptor instead.
+func (*TripMeterRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TripMeterRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *TripMeterRequest) GetTrip() ModeTrip {
+	if x != nil {
+		return x.Trip
+	}
+	return ModeTrip_MODE_TRIP_ODO
+}
+
+func (x *TripMeterRequest) GetKm() uint32 {
+	if x != nil {
+		return x.Km
+	}
+	return 0
+}
+
+type DriveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin   uint32    `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Drive ModeDrive `protobuf:"varint,2,opt,name=drive,proto3,enum=vcu.ModeDrive" json:"drive,omitempty"`
+}
+
+func (x *DriveRequest) Reset() {
+	*x = DriveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriveRequest) ProtoMessage() {}
+
+func (x *DriveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriveRequest.ProtoReflect.Descriptor instead.
+func (*DriveRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DriveRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *DriveRequest) GetDrive() ModeDrive {
+	if x != nil {
+		return x.Drive
+	}
+	return ModeDrive_MODE_DRIVE_ECONOMY
+}
+
+type TripRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin  uint32   `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Trip ModeTrip `protobuf:"varint,2,opt,name=trip,proto3,enum=vcu.ModeTrip" json:"trip,omitempty"`
+}
+
+func (x *TripRequest) Reset() {
+	*x = TripRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripRequest) ProtoMessage() {}
+
+func (x *TripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripRequest.ProtoReflect.Descriptor instead.
+func (*TripRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TripRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *TripRequest) GetTrip() ModeTrip {
+	if x != nil {
+		return x.Trip
+	}
+	return ModeTrip_MODE_TRIP_ODO
+}
+
+type AvgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin uint32  `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Avg ModeAvg `protobuf:"varint,2,opt,name=avg,proto3,enum=vcu.ModeAvg" json:"avg,omitempty"`
+}
+
+func (x *AvgRequest) Reset() {
+	*x = AvgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvgRequest) ProtoMessage() {}
+
+func (x *AvgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvgRequest.ProtoReflect.Descriptor instead.
+func (*AvgRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AvgRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *AvgRequest) GetAvg() ModeAvg {
+	if x != nil {
+		return x.Avg
+	}
+	return ModeAvg_MODE_AVG_RANGE
+}
+
+type SpeedMaxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin    uint32 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Kph    uint32 `protobuf:"varint,2,opt,name=kph,proto3" json:"kph,omitempty"`
+	UserId uint32 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *SpeedMaxRequest) Reset() {
+	*x = SpeedMaxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpeedMaxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedMaxRequest) ProtoMessage() {}
+
+func (x *SpeedMaxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedMaxRequest.ProtoReflect.Descriptor instead.
+func (*SpeedMaxRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SpeedMaxRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *SpeedMaxRequest) GetKph() uint32 {
+	if x != nil {
+		return x.Kph
+	}
+	return 0
+}
+
+func (x *SpeedMaxRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SetDriveModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin    uint32    `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Mode   ModeDrive `protobuf:"varint,2,opt,name=mode,proto3,enum=vcu.ModeDrive" json:"mode,omitempty"`
+	UserId uint32    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *SetDriveModeRequest) Reset() {
+	*x = SetDriveModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDriveModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDriveModeRequest) ProtoMessage() {}
+
+func (x *SetDriveModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDriveModeRequest.ProtoReflect.Descriptor instead.
+func (*SetDriveModeRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetDriveModeRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *SetDriveModeRequest) GetMode() ModeDrive {
+	if x != nil {
+		return x.Mode
+	}
+	return ModeDrive_MODE_DRIVE_ECONOMY
+}
+
+func (x *SetDriveModeRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type McuTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discur uint32 `protobuf:"varint,1,opt,name=discur,proto3" json:"discur,omitempty"`
+	Torque uint32 `protobuf:"varint,2,opt,name=torque,proto3" json:"torque,omitempty"`
+}
+
+func (x *McuTemplate) Reset() {
+	*x = McuTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *McuTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*McuTemplate) ProtoMessage() {}
+
+func (x *McuTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use McuTemplate.ProtoReflect.Descriptor instead.
+func (*McuTemplate) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *McuTemplate) GetDiscur() uint32 {
+	if x != nil {
+		return x.Discur
+	}
+	return 0
+}
+
+func (x *McuTemplate) GetTorque() uint32 {
+	if x != nil {
+		return x.Torque
+	}
+	return 0
+}
+
+type TemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vin uint32 `protobuf:"varint,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	// templates is one per drive mode, ordered by ModeDrive.
+	Templates []*McuTemplate `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *TemplatesRequest) Reset() {
+	*x = TemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplatesRequest) ProtoMessage() {}
+
+func (x *TemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplatesRequest.ProtoReflect.Descriptor instead.
+func (*TemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TemplatesRequest) GetVin() uint32 {
+	if x != nil {
+		return x.Vin
+	}
+	return 0
+}
+
+func (x *TemplatesRequest) GetTemplates() []*McuTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CommandReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CommandReply) Reset() {
+	*x = CommandReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandReply) ProtoMessage() {}
+
+func (x *CommandReply) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandReply.ProtoReflect.Descriptor instead.
+func (*CommandReply) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{19}
+}
+
+type TextReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *TextReply) Reset() {
+	*x = TextReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextReply) ProtoMessage() {}
+
+func (x *TextReply) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextReply.ProtoReflect.Descriptor instead.
+func (*TextReply) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TextReply) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type FingerFetchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []uint32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *FingerFetchReply) Reset() {
+	*x = FingerFetchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FingerFetchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FingerFetchReply) ProtoMessage() {}
+
+func (x *FingerFetchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FingerFetchReply.ProtoReflect.Descriptor instead.
+func (*FingerFetchReply) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FingerFetchReply) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type FingerAddReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FingerAddReply) Reset() {
+	*x = FingerAddReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FingerAddReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FingerAddReply) ProtoMessage() {}
+
+func (x *FingerAddReply) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FingerAddReply.ProtoReflect.Descriptor instead.
+func (*FingerAddReply) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FingerAddReply) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Report_Meta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendDatetime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=send_datetime,json=sendDatetime,proto3" json:"send_datetime,omitempty"`
+	LogDatetime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=log_datetime,json=logDatetime,proto3" json:"log_datetime,omitempty"`
+	Frame        Frame                  `protobuf:"varint,3,opt,name=frame,proto3,enum=vcu.Frame" json:"frame,omitempty"`
+	Queued       *uint32                `protobuf:"varint,4,opt,name=queued,proto3,oneof" json:"queued,omitempty"`
+}
+
+func (x *Report_Meta) Reset() {
+	*x = Report_Meta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Meta) ProtoMessage() {}
+
+func (x *Report_Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Meta.ProtoReflect.Descriptor instead.
+func (*Report_Meta) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Report_Meta) GetSendDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendDatetime
+	}
+	return nil
+}
+
+func (x *Report_Meta) GetLogDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LogDatetime
+	}
+	return nil
+}
+
+func (x *Report_Meta) GetFrame() Frame {
+	if x != nil {
+		return x.Frame
+	}
+	return Frame_FRAME_INVALID
+}
+
+func (x *Report_Meta) GetQueued() uint32 {
+	if x != nil && x.Queued != nil {
+		return *x.Queued
+	}
+	return 0
+}
+
+type Report_Vcu struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State      *BikeState `protobuf:"varint,1,opt,name=state,proto3,enum=vcu.BikeState,oneof" json:"state,omitempty"`
+	Events     *uint32    `protobuf:"varint,2,opt,name=events,proto3,oneof" json:"events,omitempty"`
+	LockDown   *bool      `protobuf:"varint,3,opt,name=lock_down,json=lockDown,proto3,oneof" json:"lock_down,omitempty"`
+	CanDebug   *bool      `protobuf:"varint,4,opt,name=can_debug,json=canDebug,proto3,oneof" json:"can_debug,omitempty"`
+	BatVoltage *float32   `protobuf:"fixed32,5,opt,name=bat_voltage,json=batVoltage,proto3,oneof" json:"bat_voltage,omitempty"`
+	Uptime     *float32   `protobuf:"fixed32,6,opt,name=uptime,proto3,oneof" json:"uptime,omitempty"`
+	Version    *uint32    `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty"`
+}
+
+func (x *Report_Vcu) Reset() {
+	*x = Report_Vcu{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Vcu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Vcu) ProtoMessage() {}
+
+func (x *Report_Vcu) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Vcu.ProtoReflect.Descriptor instead.
+func (*Report_Vcu) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Report_Vcu) GetState() BikeState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return BikeState_BIKE_STATE_NORMAL
+}
+
+func (x *Report_Vcu) GetEvents() uint32 {
+	if x != nil && x.Events != nil {
+		return *x.Events
+	}
+	return 0
+}
+
+func (x *Report_Vcu) GetLockDown() bool {
+	if x != nil && x.LockDown != nil {
+		return *x.LockDown
+	}
+	return false
+}
+
+func (x *Report_Vcu) GetCanDebug() bool {
+	if x != nil && x.CanDebug != nil {
+		return *x.CanDebug
+	}
+	return false
+}
+
+func (x *Report_Vcu) GetBatVoltage() float32 {
+	if x != nil && x.BatVoltage != nil {
+		return *x.BatVoltage
+	}
+	return 0
+}
+
+func (x *Report_Vcu) GetUptime() float32 {
+	if x != nil && x.Uptime != nil {
+		return *x.Uptime
+	}
+	return 0
+}
+
+func (x *Report_Vcu) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type Report_Eeprom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active *bool   `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Used   *uint32 `protobuf:"varint,2,opt,name=used,proto3,oneof" json:"used,omitempty"`
+}
+
+func (x *Report_Eeprom) Reset() {
+	*x = Report_Eeprom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Eeprom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Eeprom) ProtoMessage() {}
+
+func (x *Report_Eeprom) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Eeprom.ProtoReflect.Descriptor instead.
+func (*Report_Eeprom) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Report_Eeprom) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Eeprom) GetUsed() uint32 {
+	if x != nil && x.Used != nil {
+		return *x.Used
+	}
+	return 0
+}
+
+type Report_Gps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active    *bool    `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	SatInUse  *uint32  `protobuf:"varint,2,opt,name=sat_in_use,json=satInUse,proto3,oneof" json:"sat_in_use,omitempty"`
+	Hdop      *float32 `protobuf:"fixed32,3,opt,name=hdop,proto3,oneof" json:"hdop,omitempty"`
+	Vdop      *float32 `protobuf:"fixed32,4,opt,name=vdop,proto3,oneof" json:"vdop,omitempty"`
+	Speed     *uint32  `protobuf:"varint,5,opt,name=speed,proto3,oneof" json:"speed,omitempty"`
+	Heading   *float32 `protobuf:"fixed32,6,opt,name=heading,proto3,oneof" json:"heading,omitempty"`
+	Longitude *float32 `protobuf:"fixed32,7,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Latitude  *float32 `protobuf:"fixed32,8,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Altitude  *float32 `protobuf:"fixed32,9,opt,name=altitude,proto3,oneof" json:"altitude,omitempty"`
+}
+
+func (x *Report_Gps) Reset() {
+	*x = Report_Gps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Gps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Gps) ProtoMessage() {}
+
+func (x *Report_Gps) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Gps.ProtoReflect.Descriptor instead.
+func (*Report_Gps) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Report_Gps) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Gps) GetSatInUse() uint32 {
+	if x != nil && x.SatInUse != nil {
+		return *x.SatInUse
+	}
+	return 0
+}
+
+func (x *Report_Gps) GetHdop() float32 {
+	if x != nil && x.Hdop != nil {
+		return *x.Hdop
+	}
+	return 0
+}
+
+func (x *Report_Gps) GetVdop() float32 {
+	if x != nil && x.Vdop != nil {
+		return *x.Vdop
+	}
+	return 0
+}
+
+func (x *Report_Gps) GetSpeed() uint32 {
+	if x != nil && x.Speed != nil {
+		return *x.Speed
+	}
+	return 0
+}
+
+func (x *Report_Gps) GetHeading() float32 {
+	if x != nil && x.Heading != nil {
+		return *x.Heading
+	}
+	return 0
+}
+
+func (x *Report_Gps) GetLongitude() float32 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *Report_Gps) GetLatitude() float32 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Report_Gps) GetAltitude() float32 {
+	if x != nil && x.Altitude != nil {
+		return *x.Altitude
+	}
+	return 0
+}
+
+type Report_Hbar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reverse *bool             `protobuf:"varint,1,opt,name=reverse,proto3,oneof" json:"reverse,omitempty"`
+	Mode    *Report_Hbar_Mode `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Trip    *Report_Hbar_Trip `protobuf:"bytes,3,opt,name=trip,proto3" json:"trip,omitempty"`
+	Avg     *Report_Hbar_Avg  `protobuf:"bytes,4,opt,name=avg,proto3" json:"avg,omitempty"`
+}
+
+func (x *Report_Hbar) Reset() {
+	*x = Report_Hbar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Hbar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Hbar) ProtoMessage() {}
+
+func (x *Report_Hbar) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Hbar.ProtoReflect.Descriptor instead.
+func (*Report_Hbar) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Report_Hbar) GetReverse() bool {
+	if x != nil && x.Reverse != nil {
+		return *x.Reverse
+	}
+	return false
+}
+
+func (x *Report_Hbar) GetMode() *Report_Hbar_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return nil
+}
+
+func (x *Report_Hbar) GetTrip() *Report_Hbar_Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+func (x *Report_Hbar) GetAvg() *Report_Hbar_Avg {
+	if x != nil {
+		return x.Avg
+	}
+	return nil
+}
+
+type Report_Net struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signal *uint32 `protobuf:"varint,1,opt,name=signal,proto3,oneof" json:"signal,omitempty"`
+	State  *int32  `protobuf:"varint,2,opt,name=state,proto3,oneof" json:"state,omitempty"`
+}
+
+func (x *Report_Net) Reset() {
+	*x = Report_Net{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Net) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Net) ProtoMessage() {}
+
+func (x *Report_Net) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Net.ProtoReflect.Descriptor instead.
+func (*Report_Net) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Report_Net) GetSignal() uint32 {
+	if x != nil && x.Signal != nil {
+		return *x.Signal
+	}
+	return 0
+}
+
+func (x *Report_Net) GetState() int32 {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return 0
+}
+
+type Report_Imu struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active    *bool             `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	AntiThief *bool             `protobuf:"varint,2,opt,name=anti_thief,json=antiThief,proto3,oneof" json:"anti_thief,omitempty"`
+	IsFallen  *bool             `protobuf:"varint,3,opt,name=is_fallen,json=isFallen,proto3,oneof" json:"is_fallen,omitempty"`
+	Total     *Report_Imu_Total `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Tilt      *Report_Imu_Tilt  `protobuf:"bytes,5,opt,name=tilt,proto3" json:"tilt,omitempty"`
+}
+
+func (x *Report_Imu) Reset() {
+	*x = Report_Imu{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Imu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Imu) ProtoMessage() {}
+
+func (x *Report_Imu) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Imu.ProtoReflect.Descriptor instead.
+func (*Report_Imu) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Report_Imu) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Imu) GetAntiThief() bool {
+	if x != nil && x.AntiThief != nil {
+		return *x.AntiThief
+	}
+	return false
+}
+
+func (x *Report_Imu) GetIsFallen() bool {
+	if x != nil && x.IsFallen != nil {
+		return *x.IsFallen
+	}
+	return false
+}
+
+func (x *Report_Imu) GetTotal() *Report_Imu_Total {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Report_Imu) GetTilt() *Report_Imu_Tilt {
+	if x != nil {
+		return x.Tilt
+	}
+	return nil
+}
+
+type Report_Remote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active *bool `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Nearby *bool `protobuf:"varint,2,opt,name=nearby,proto3,oneof" json:"nearby,omitempty"`
+}
+
+func (x *Report_Remote) Reset() {
+	*x = Report_Remote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Remote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Remote) ProtoMessage() {}
+
+func (x *Report_Remote) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Remote.ProtoReflect.Descriptor instead.
+func (*Report_Remote) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *Report_Remote) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Remote) GetNearby() bool {
+	if x != nil && x.Nearby != nil {
+		return *x.Nearby
+	}
+	return false
+}
+
+type Report_Finger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active   *bool   `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	DriverId *uint32 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+}
+
+func (x *Report_Finger) Reset() {
+	*x = Report_Finger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Finger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Finger) ProtoMessage() {}
+
+func (x *Report_Finger) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Finger.ProtoReflect.Descriptor instead.
+func (*Report_Finger) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 8}
+}
+
+func (x *Report_Finger) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Finger) GetDriverId() uint32 {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return 0
+}
+
+type Report_Audio struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active *bool   `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Mute   *uint32 `protobuf:"varint,2,opt,name=mute,proto3,oneof" json:"mute,omitempty"`
+	Volume *uint32 `protobuf:"varint,3,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+}
+
+func (x *Report_Audio) Reset() {
+	*x = Report_Audio{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Audio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Audio) ProtoMessage() {}
+
+func (x *Report_Audio) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Audio.ProtoReflect.Descriptor instead.
+func (*Report_Audio) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 9}
+}
+
+func (x *Report_Audio) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Audio) GetMute() uint32 {
+	if x != nil && x.Mute != nil {
+		return *x.Mute
+	}
+	return 0
+}
+
+func (x *Report_Audio) GetVolume() uint32 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
+	}
+	return 0
+}
+
+type Report_Hmi struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active  *bool   `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Version *uint32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+}
+
+func (x *Report_Hmi) Reset() {
+	*x = Report_Hmi{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Hmi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Hmi) ProtoMessage() {}
+
+func (x *Report_Hmi) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Hmi.ProtoReflect.Descriptor instead.
+func (*Report_Hmi) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 10}
+}
+
+func (x *Report_Hmi) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Hmi) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type Report_Capacity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remaining *uint32 `protobuf:"varint,1,opt,name=remaining,proto3,oneof" json:"remaining,omitempty"`
+	Usage     *uint32 `protobuf:"varint,2,opt,name=usage,proto3,oneof" json:"usage,omitempty"`
+}
+
+func (x *Report_Capacity) Reset() {
+	*x = Report_Capacity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Capacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Capacity) ProtoMessage() {}
+
+func (x *Report_Capacity) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Capacity.ProtoReflect.Descriptor instead.
+func (*Report_Capacity) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 11}
+}
+
+func (x *Report_Capacity) GetRemaining() uint32 {
+	if x != nil && x.Remaining != nil {
+		return *x.Remaining
+	}
+	return 0
+}
+
+func (x *Report_Capacity) GetUsage() uint32 {
+	if x != nil && x.Usage != nil {
+		return *x.Usage
+	}
+	return 0
+}
+
+type Report_Bms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active   *bool              `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Run      *bool              `protobuf:"varint,2,opt,name=run,proto3,oneof" json:"run,omitempty"`
+	Soc      *uint32            `protobuf:"varint,3,opt,name=soc,proto3,oneof" json:"soc,omitempty"`
+	Faults   *uint32            `protobuf:"varint,4,opt,name=faults,proto3,oneof" json:"faults,omitempty"`
+	Capacity *Report_Capacity   `protobuf:"bytes,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Pack     []*Report_Bms_Pack `protobuf:"bytes,6,rep,name=pack,proto3" json:"pack,omitempty"`
+}
+
+func (x *Report_Bms) Reset() {
+	*x = Report_Bms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Bms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Bms) ProtoMessage() {}
+
+func (x *Report_Bms) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Bms.ProtoReflect.Descriptor instead.
+func (*Report_Bms) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 12}
+}
+
+func (x *Report_Bms) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Bms) GetRun() bool {
+	if x != nil && x.Run != nil {
+		return *x.Run
+	}
+	return false
+}
+
+func (x *Report_Bms) GetSoc() uint32 {
+	if x != nil && x.Soc != nil {
+		return *x.Soc
+	}
+	return 0
+}
+
+func (x *Report_Bms) GetFaults() uint32 {
+	if x != nil && x.Faults != nil {
+		return *x.Faults
+	}
+	return 0
+}
+
+func (x *Report_Bms) GetCapacity() *Report_Capacity {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *Report_Bms) GetPack() []*Report_Bms_Pack {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+type Report_Mcu struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active      *bool                `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Run         *bool                `protobuf:"varint,2,opt,name=run,proto3,oneof" json:"run,omitempty"`
+	Reverse     *bool                `protobuf:"varint,3,opt,name=reverse,proto3,oneof" json:"reverse,omitempty"`
+	DriveMode   *ModeDrive           `protobuf:"varint,4,opt,name=drive_mode,json=driveMode,proto3,enum=vcu.ModeDrive,oneof" json:"drive_mode,omitempty"`
+	Speed       *uint32              `protobuf:"varint,5,opt,name=speed,proto3,oneof" json:"speed,omitempty"`
+	Rpm         *int32               `protobuf:"varint,6,opt,name=rpm,proto3,oneof" json:"rpm,omitempty"`
+	Temperature *int32               `protobuf:"varint,7,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	IsOverSpeed *bool                `protobuf:"varint,8,opt,name=is_over_speed,json=isOverSpeed,proto3,oneof" json:"is_over_speed,omitempty"`
+	Faults      *Report_Mcu_Faults   `protobuf:"bytes,9,opt,name=faults,proto3" json:"faults,omitempty"`
+	Torque      *Report_Mcu_Torque   `protobuf:"bytes,10,opt,name=torque,proto3" json:"torque,omitempty"`
+	DcBus       *Report_Mcu_DcBus    `protobuf:"bytes,11,opt,name=dc_bus,json=dcBus,proto3" json:"dc_bus,omitempty"`
+	Template    *Report_Mcu_Template `protobuf:"bytes,12,opt,name=template,proto3" json:"template,omitempty"`
+	// template_list is Mcu.Template of version 3.
+	TemplateList []*Report_Mcu_Setting `protobuf:"bytes,13,rep,name=template_list,json=templateList,proto3" json:"template_list,omitempty"`
+	Setting      []*Report_Mcu_Setting `protobuf:"bytes,14,rep,name=setting,proto3" json:"setting,omitempty"`
+}
+
+func (x *Report_Mcu) Reset() {
+	*x = Report_Mcu{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Mcu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Mcu) ProtoMessage() {}
+
+func (x *Report_Mcu) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Mcu.ProtoReflect.Descriptor instead.
+func (*Report_Mcu) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 13}
+}
+
+func (x *Report_Mcu) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *Report_Mcu) GetRun() bool {
+	if x != nil && x.Run != nil {
+		return *x.Run
+	}
+	return false
+}
+
+func (x *Report_Mcu) GetReverse() bool {
+	if x != nil && x.Reverse != nil {
+		return *x.Reverse
+	}
+	return false
+}
+
+func (x *Report_Mcu) GetDriveMode() ModeDrive {
+	if x != nil && x.DriveMode != nil {
+		return *x.DriveMode
+	}
+	return ModeDrive_MODE_DRIVE_ECONOMY
+}
+
+func (x *Report_Mcu) GetSpeed() uint32 {
+	if x != nil && x.Speed != nil {
+		return *x.Speed
+	}
+	return 0
+}
+
+func (x *Report_Mcu) GetRpm() int32 {
+	if x != nil && x.Rpm != nil {
+		return *x.Rpm
+	}
+	return 0
+}
+
+func (x *Report_Mcu) GetTemperature() int32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *Report_Mcu) GetIsOverSpeed() bool {
+	if x != nil && x.IsOverSpeed != nil {
+		return *x.IsOverSpeed
+	}
+	return false
+}
+
+func (x *Report_Mcu) GetFaults() *Report_Mcu_Faults {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+func (x *Report_Mcu) GetTorque() *Report_Mcu_Torque {
+	if x != nil {
+		return x.Torque
+	}
+	return nil
+}
+
+func (x *Report_Mcu) GetDcBus() *Report_Mcu_DcBus {
+	if x != nil {
+		return x.DcBus
+	}
+	return nil
+}
+
+func (x *Report_Mcu) GetTemplate() *Report_Mcu_Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *Report_Mcu) GetTemplateList() []*Report_Mcu_Setting {
+	if x != nil {
+		return x.TemplateList
+	}
+	return nil
+}
+
+func (x *Report_Mcu) GetSetting() []*Report_Mcu_Setting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type Report_Task struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stack  *Report_Task_Tasks `protobuf:"bytes,1,opt,name=stack,proto3" json:"stack,omitempty"`
+	Wakeup *Report_Task_Tasks `protobuf:"bytes,2,opt,name=wakeup,proto3" json:"wakeup,omitempty"`
+}
+
+func (x *Report_Task) Reset() {
+	*x = Report_Task{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Task) ProtoMessage() {}
+
+func (x *Report_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Task.ProtoReflect.Descriptor instead.
+func (*Report_Task) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 14}
+}
+
+func (x *Report_Task) GetStack() *Report_Task_Tasks {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+func (x *Report_Task) GetWakeup() *Report_Task_Tasks {
+	if x != nil {
+		return x.Wakeup
+	}
+	return nil
+}
+
+type Report_Hbar_Mode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drive *ModeDrive `protobuf:"varint,1,opt,name=drive,proto3,enum=vcu.ModeDrive,oneof" json:"drive,omitempty"`
+	Trip  *ModeTrip  `protobuf:"varint,2,opt,name=trip,proto3,enum=vcu.ModeTrip,oneof" json:"trip,omitempty"`
+	Avg   *ModeAvg   `protobuf:"varint,3,opt,name=avg,proto3,enum=vcu.ModeAvg,oneof" json:"avg,omitempty"`
+}
+
+func (x *Report_Hbar_Mode) Reset() {
+	*x = Report_Hbar_Mode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Hbar_Mode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Hbar_Mode) ProtoMessage() {}
+
+func (x *Report_Hbar_Mode) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Hbar_Mode.ProtoReflect.Descriptor instead.
+func (*Report_Hbar_Mode) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 4, 0}
+}
+
+func (x *Report_Hbar_Mode) GetDrive() ModeDrive {
+	if x != nil && x.Drive != nil {
+		return *x.Drive
+	}
+	return ModeDrive_MODE_DRIVE_ECONOMY
+}
+
+func (x *Report_Hbar_Mode) GetTrip() ModeTrip {
+	if x != nil && x.Trip != nil {
+		return *x.Trip
+	}
+	return ModeTrip_MODE_TRIP_ODO
+}
+
+func (x *Report_Hbar_Mode) GetAvg() ModeAvg {
+	if x != nil && x.Avg != nil {
+		return *x.Avg
+	}
+	return ModeAvg_MODE_AVG_RANGE
+}
+
+type Report_Hbar_Trip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A   *uint32 `protobuf:"varint,1,opt,name=a,proto3,oneof" json:"a,omitempty"`
+	B   *uint32 `protobuf:"varint,2,opt,name=b,proto3,oneof" json:"b,omitempty"`
+	Odo *uint32 `protobuf:"varint,3,opt,name=odo,proto3,oneof" json:"odo,omitempty"`
+}
+
+func (x *Report_Hbar_Trip) Reset() {
+	*x = Report_Hbar_Trip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Hbar_Trip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Hbar_Trip) ProtoMessage() {}
+
+func (x *Report_Hbar_Trip) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Hbar_Trip.ProtoReflect.Descriptor instead.
+func (*Report_Hbar_Trip) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 4, 1}
+}
+
+func (x *Report_Hbar_Trip) GetA() uint32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *Report_Hbar_Trip) GetB() uint32 {
+	if x != nil && x.B != nil {
+		return *x.B
+	}
+	return 0
+}
+
+func (x *Report_Hbar_Trip) GetOdo() uint32 {
+	if x != nil && x.Odo != nil {
+		return *x.Odo
+	}
+	return 0
+}
+
+type Report_Hbar_Avg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Range      *uint32 `protobuf:"varint,1,opt,name=range,proto3,oneof" json:"range,omitempty"`
+	Efficiency *uint32 `protobuf:"varint,2,opt,name=efficiency,proto3,oneof" json:"efficiency,omitempty"`
+}
+
+func (x *Report_Hbar_Avg) Reset() {
+	*x = Report_Hbar_Avg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Hbar_Avg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Hbar_Avg) ProtoMessage() {}
+
+func (x *Report_Hbar_Avg) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Hbar_Avg.ProtoReflect.Descriptor instead.
+func (*Report_Hbar_Avg) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 4, 2}
+}
+
+func (x *Report_Hbar_Avg) GetRange() uint32 {
+	if x != nil && x.Range != nil {
+		return *x.Range
+	}
+	return 0
+}
+
+func (x *Report_Hbar_Avg) GetEfficiency() uint32 {
+	if x != nil && x.Efficiency != nil {
+		return *x.Efficiency
+	}
+	return 0
+}
+
+type Report_Imu_Total struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accel       *float32 `protobuf:"fixed32,1,opt,name=accel,proto3,oneof" json:"accel,omitempty"`
+	Gyro        *float32 `protobuf:"fixed32,2,opt,name=gyro,proto3,oneof" json:"gyro,omitempty"`
+	Tilt        *float32 `protobuf:"fixed32,3,opt,name=tilt,proto3,oneof" json:"tilt,omitempty"`
+	Temperature *int32   `protobuf:"varint,4,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+}
+
+func (x *Report_Imu_Total) Reset() {
+	*x = Report_Imu_Total{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Imu_Total) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Imu_Total) ProtoMessage() {}
+
+func (x *Report_Imu_Total) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Imu_Total.ProtoReflect.Descriptor instead.
+func (*Report_Imu_Total) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 6, 0}
+}
+
+func (x *Report_Imu_Total) GetAccel() float32 {
+	if x != nil && x.Accel != nil {
+		return *x.Accel
+	}
+	return 0
+}
+
+func (x *Report_Imu_Total) GetGyro() float32 {
+	if x != nil && x.Gyro != nil {
+		return *x.Gyro
+	}
+	return 0
+}
+
+func (x *Report_Imu_Total) GetTilt() float32 {
+	if x != nil && x.Tilt != nil {
+		return *x.Tilt
+	}
+	return 0
+}
+
+func (x *Report_Imu_Total) GetTemperature() int32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+type Report_Imu_Tilt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pitch *float32 `protobuf:"fixed32,1,opt,name=pitch,proto3,oneof" json:"pitch,omitempty"`
+	Roll  *float32 `protobuf:"fixed32,2,opt,name=roll,proto3,oneof" json:"roll,omitempty"`
+}
+
+func (x *Report_Imu_Tilt) Reset() {
+	*x = Report_Imu_Tilt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Imu_Tilt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Imu_Tilt) ProtoMessage() {}
+
+func (x *Report_Imu_Tilt) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Imu_Tilt.ProtoReflect.Descriptor instead.
+func (*Report_Imu_Tilt) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 6, 1}
+}
+
+func (x *Report_Imu_Tilt) GetPitch() float32 {
+	if x != nil && x.Pitch != nil {
+		return *x.Pitch
+	}
+	return 0
+}
+
+func (x *Report_Imu_Tilt) GetRoll() float32 {
+	if x != nil && x.Roll != nil {
+		return *x.Roll
+	}
+	return 0
+}
+
+type Report_Bms_Pack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          *uint32          `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Voltage     *float32         `protobuf:"fixed32,2,opt,name=voltage,proto3,oneof" json:"voltage,omitempty"`
+	Current     *float32         `protobuf:"fixed32,3,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	Soc         *uint32          `protobuf:"varint,4,opt,name=soc,proto3,oneof" json:"soc,omitempty"`
+	Soh         *uint32          `protobuf:"varint,5,opt,name=soh,proto3,oneof" json:"soh,omitempty"`
+	Temperature *int32           `protobuf:"varint,6,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	Faults      *uint32          `protobuf:"varint,7,opt,name=faults,proto3,oneof" json:"faults,omitempty"`
+	Capacity    *Report_Capacity `protobuf:"bytes,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *Report_Bms_Pack) Reset() {
+	*x = Report_Bms_Pack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Bms_Pack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Bms_Pack) ProtoMessage() {}
+
+func (x *Report_Bms_Pack) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Bms_Pack.ProtoReflect.Descriptor instead.
+func (*Report_Bms_Pack) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 12, 0}
+}
+
+func (x *Report_Bms_Pack) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Report_Bms_Pack) GetVoltage() float32 {
+	if x != nil && x.Voltage != nil {
+		return *x.Voltage
+	}
+	return 0
+}
+
+func (x *Report_Bms_Pack) GetCurrent() float32 {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return 0
+}
+
+func (x *Report_Bms_Pack) GetSoc() uint32 {
+	if x != nil && x.Soc != nil {
+		return *x.Soc
+	}
+	return 0
+}
+
+func (x *Report_Bms_Pack) GetSoh() uint32 {
+	if x != nil && x.Soh != nil {
+		return *x.Soh
+	}
+	return 0
+}
+
+func (x *Report_Bms_Pack) GetTemperature() int32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *Report_Bms_Pack) GetFaults() uint32 {
+	if x != nil && x.Faults != nil {
+		return *x.Faults
+	}
+	return 0
+}
+
+func (x *Report_Bms_Pack) GetCapacity() *Report_Capacity {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+type Report_Mcu_Faults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *uint32 `protobuf:"varint,1,opt,name=post,proto3,oneof" json:"post,omitempty"`
+	Run  *uint32 `protobuf:"varint,2,opt,name=run,proto3,oneof" json:"run,omitempty"`
+}
+
+func (x *Report_Mcu_Faults) Reset() {
+	*x = Report_Mcu_Faults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Mcu_Faults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Mcu_Faults) ProtoMessage() {}
+
+func (x *Report_Mcu_Faults) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Mcu_Faults.ProtoReflect.Descriptor instead.
+func (*Report_Mcu_Faults) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 13, 0}
+}
+
+func (x *Report_Mcu_Faults) GetPost() uint32 {
+	if x != nil && x.Post != nil {
+		return *x.Post
+	}
+	return 0
+}
+
+func (x *Report_Mcu_Faults) GetRun() uint32 {
+	if x != nil && x.Run != nil {
+		return *x.Run
+	}
+	return 0
+}
+
+type Report_Mcu_Torque struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commanded *float32 `protobuf:"fixed32,1,opt,name=commanded,proto3,oneof" json:"commanded,omitempty"`
+	Feedback  *float32 `protobuf:"fixed32,2,opt,name=feedback,proto3,oneof" json:"feedback,omitempty"`
+}
+
+func (x *Report_Mcu_Torque) Reset() {
+	*x = Report_Mcu_Torque{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Mcu_Torque) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Mcu_Torque) ProtoMessage() {}
+
+func (x *Report_Mcu_Torque) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Mcu_Torque.ProtoReflect.Descriptor instead.
+func (*Report_Mcu_Torque) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 13, 1}
+}
+
+func (x *Report_Mcu_Torque) GetCommanded() float32 {
+	if x != nil && x.Commanded != nil {
+		return *x.Commanded
+	}
+	return 0
+}
+
+func (x *Report_Mcu_Torque) GetFeedback() float32 {
+	if x != nil && x.Feedback != nil {
+		return *x.Feedback
+	}
+	return 0
+}
+
+type Report_Mcu_DcBus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Current *float32 `protobuf:"fixed32,1,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	Voltage *float32 `protobuf:"fixed32,2,opt,name=voltage,proto3,oneof" json:"voltage,omitempty"`
+}
+
+func (x *Report_Mcu_DcBus) Reset() {
+	*x = Report_Mcu_DcBus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Mcu_DcBus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Mcu_DcBus) ProtoMessage() {}
+
+func (x *Report_Mcu_DcBus) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Mcu_DcBus.ProtoReflect.Descriptor instead.
+func (*Report_Mcu_DcBus) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 13, 2}
+}
+
+func (x *Report_Mcu_DcBus) GetCurrent() float32 {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return 0
+}
+
+func (x *Report_Mcu_DcBus) GetVoltage() float32 {
+	if x != nil && x.Voltage != nil {
+		return *x.Voltage
+	}
+	return 0
+}
+
+type Report_Mcu_Template struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxRpm    *int32                           `protobuf:"varint,1,opt,name=max_rpm,json=maxRpm,proto3,oneof" json:"max_rpm,omitempty"`
+	MaxSpeed  *uint32                          `protobuf:"varint,2,opt,name=max_speed,json=maxSpeed,proto3,oneof" json:"max_speed,omitempty"`
+	DriveMode []*Report_Mcu_Template_DriveMode `protobuf:"bytes,3,rep,name=drive_mode,json=driveMode,proto3" json:"drive_mode,omitempty"`
+}
+
+func (x *Report_Mcu_Template) Reset() {
+	*x = Report_Mcu_Template{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Mcu_Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Mcu_Template) ProtoMessage() {}
+
+func (x *Report_Mcu_Template) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Mcu_Template.ProtoReflect.Descriptor instead.
+func (*Report_Mcu_Template) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 13, 3}
+}
+
+func (x *Report_Mcu_Template) GetMaxRpm() int32 {
+	if x != nil && x.MaxRpm != nil {
+		return *x.MaxRpm
+	}
+	return 0
+}
+
+func (x *Report_Mcu_Template) GetMaxSpeed() uint32 {
+	if x != nil && x.MaxSpeed != nil {
+		return *x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *Report_Mcu_Template) GetDriveMode() []*Report_Mcu_Template_DriveMode {
+	if x != nil {
+		return x.DriveMode
+	}
+	return nil
+}
+
+type Report_Mcu_Setting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DriveMode *ModeDrive `protobuf:"varint,1,opt,name=drive_mode,json=driveMode,proto3,enum=vcu.ModeDrive,oneof" json:"drive_mode,omitempty"`
+	MaxSpeed  *uint32    `protobuf:"varint,2,opt,name=max_speed,json=maxSpeed,proto3,oneof" json:"max_speed,omitempty"`
+}
+
+func (x *Report_Mcu_Setting) Reset() {
+	*x = Report_Mcu_Setting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Mcu_Setting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Mcu_Setting) ProtoMessage() {}
+
+func (x *Report_Mcu_Setting) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Mcu_Setting.ProtoReflect.Descriptor instead.
+func (*Report_Mcu_Setting) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 13, 4}
+}
+
+func (x *Report_Mcu_Setting) GetDriveMode() ModeDrive {
+	if x != nil && x.DriveMode != nil {
+		return *x.DriveMode
+	}
+	return ModeDrive_MODE_DRIVE_ECONOMY
+}
+
+func (x *Report_Mcu_Setting) GetMaxSpeed() uint32 {
+	if x != nil && x.MaxSpeed != nil {
+		return *x.MaxSpeed
+	}
+	return 0
+}
+
+type Report_Mcu_Template_DriveMode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discur *uint32 `protobuf:"varint,1,opt,name=discur,proto3,oneof" json:"discur,omitempty"`
+	Torque *uint32 `protobuf:"varint,2,opt,name=torque,proto3,oneof" json:"torque,omitempty"`
+}
+
+func (x *Report_Mcu_Template_DriveMode) Reset() {
+	*x = Report_Mcu_Template_DriveMode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Mcu_Template_DriveMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Mcu_Template_DriveMode) ProtoMessage() {}
+
+func (x *Report_Mcu_Template_DriveMode) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Mcu_Template_DriveMode.ProtoReflect.Descriptor instead.
+func (*Report_Mcu_Template_DriveMode) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 13, 3, 0}
+}
+
+func (x *Report_Mcu_Template_DriveMode) GetDiscur() uint32 {
+	if x != nil && x.Discur != nil {
+		return *x.Discur
+	}
+	return 0
+}
+
+func (x *Report_Mcu_Template_DriveMode) GetTorque() uint32 {
+	if x != nil && x.Torque != nil {
+		return *x.Torque
+	}
+	return 0
+}
+
+type Report_Task_Tasks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manager  *uint32 `protobuf:"varint,1,opt,name=manager,proto3,oneof" json:"manager,omitempty"`
+	Network  *uint32 `protobuf:"varint,2,opt,name=network,proto3,oneof" json:"network,omitempty"`
+	Reporter *uint32 `protobuf:"varint,3,opt,name=reporter,proto3,oneof" json:"reporter,omitempty"`
+	Command  *uint32 `protobuf:"varint,4,opt,name=command,proto3,oneof" json:"command,omitempty"`
+	Imu      *uint32 `protobuf:"varint,5,opt,name=imu,proto3,oneof" json:"imu,omitempty"`
+	Remote   *uint32 `protobuf:"varint,6,opt,name=remote,proto3,oneof" json:"remote,omitempty"`
+	Finger   *uint32 `protobuf:"varint,7,opt,name=finger,proto3,oneof" json:"finger,omitempty"`
+	Audio    *uint32 `protobuf:"varint,8,opt,name=audio,proto3,oneof" json:"audio,omitempty"`
+	Gate     *uint32 `protobuf:"varint,9,opt,name=gate,proto3,oneof" json:"gate,omitempty"`
+	CanRx    *uint32 `protobuf:"varint,10,opt,name=can_rx,json=canRx,proto3,oneof" json:"can_rx,omitempty"`
+	CanTx    *uint32 `protobuf:"varint,11,opt,name=can_tx,json=canTx,proto3,oneof" json:"can_tx,omitempty"`
+}
+
+func (x *Report_Task_Tasks) Reset() {
+	*x = Report_Task_Tasks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vcu_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report_Task_Tasks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report_Task_Tasks) ProtoMessage() {}
+
+func (x *Report_Task_Tasks) ProtoReflect() protoreflect.Message {
+	mi := &file_vcu_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report_Task_Tasks.ProtoReflect.Descriptor instead.
+func (*Report_Task_Tasks) Descriptor() ([]byte, []int) {
+	return file_vcu_proto_rawDescGZIP(), []int{2, 14, 0}
+}
+
+func (x *Report_Task_Tasks) GetManager() uint32 {
+	if x != nil && x.Manager != nil {
+		return *x.Manager
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetNetwork() uint32 {
+	if x != nil && x.Network != nil {
+		return *x.Network
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetReporter() uint32 {
+	if x != nil && x.Reporter != nil {
+		return *x.Reporter
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetCommand() uint32 {
+	if x != nil && x.Command != nil {
+		return *x.Command
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetImu() uint32 {
+	if x != nil && x.Imu != nil {
+		return *x.Imu
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetRemote() uint32 {
+	if x != nil && x.Remote != nil {
+		return *x.Remote
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetFinger() uint32 {
+	if x != nil && x.Finger != nil {
+		return *x.Finger
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetAudio() uint32 {
+	if x != nil && x.Audio != nil {
+		return *x.Audio
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetGate() uint32 {
+	if x != nil && x.Gate != nil {
+		return *x.Gate
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetCanRx() uint32 {
+	if x != nil && x.CanRx != nil {
+		return *x.CanRx
+	}
+	return 0
+}
+
+func (x *Report_Task_Tasks) GetCanTx() uint32 {
+	if x != nil && x.CanTx != nil {
+		return *x.CanTx
+	}
+	return 0
+}
+
+var File_vcu_proto protoreflect.FileDescriptor
+
+var file_vcu_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x76, 0x63, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x76, 0x63, 0x75,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x3a, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x04, 0x76, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x67, 0x0a,
+	0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x95, 0x2c, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x76, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x76, 0x63, 0x75, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x56, 0x63, 0x75, 0x52, 0x03, 0x76, 0x63, 0x75, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x65,
+	0x70, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x63, 0x75,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x45, 0x65, 0x70, 0x72, 0x6f, 0x6d, 0x52, 0x06,
+	0x65, 0x65, 0x70, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x03, 0x67, 0x70, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x47, 0x70, 0x73, 0x52, 0x03, 0x67, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x68, 0x62, 0x61,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x48, 0x62, 0x61, 0x72, 0x52, 0x04, 0x68, 0x62, 0x61, 0x72, 0x12,
+	0x21, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x52, 0x03, 0x6e,
+	0x65, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x69, 0x6d, 0x75, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x49, 0x6d, 0x75,
+	0x52, 0x03, 0x69, 0x6d, 0x75, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x46,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52,
+	0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x21, 0x0a, 0x03, 0x68, 0x6d, 0x69, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x48, 0x6d, 0x69, 0x52, 0x03, 0x68, 0x6d, 0x69, 0x12, 0x21, 0x0a, 0x03, 0x62, 0x6d, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x42, 0x6d, 0x73, 0x52, 0x03, 0x62, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x03,
+	0x6d, 0x63, 0x75, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x63, 0x75, 0x52, 0x03, 0x6d, 0x63, 0x75, 0x12,
+	0x24, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x04, 0x74, 0x61, 0x73, 0x6b, 0x1a, 0xd0, 0x01, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x3f,
+	0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x3d, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e,
+	0x76, 0x63, 0x75, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x1a, 0xcb, 0x02, 0x0a, 0x03, 0x56, 0x63, 0x75,
+	0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x6b, 0x44, 0x6f, 0x77, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52,
+	0x08, 0x63, 0x61, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b,
+	0x62, 0x61, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x04, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x05, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x06, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x6f, 0x77,
+	0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x61, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x52, 0x0a, 0x06, 0x45, 0x65, 0x70, 0x72, 0x6f, 0x6d,
+	0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x1a, 0x80, 0x03, 0x0a, 0x03, 0x47,
+	0x70, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x0a, 0x73, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x08, 0x73, 0x61, 0x74, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x68, 0x64, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x02, 0x52, 0x04, 0x68, 0x64, 0x6f, 0x70, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x76,
+	0x64, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03, 0x52, 0x04, 0x76, 0x64, 0x6f,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x06, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x07, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x02, 0x48, 0x08, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x68, 0x64, 0x6f, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x76, 0x64, 0x6f, 0x70, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x1a, 0x84, 0x04,
+	0x0a, 0x04, 0x48, 0x62, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x48, 0x62, 0x61, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x74, 0x72, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x48, 0x62, 0x61, 0x72,
+	0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x03, 0x61,
+	0x76, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x48, 0x62, 0x61, 0x72, 0x2e, 0x41, 0x76, 0x67, 0x52, 0x03,
+	0x61, 0x76, 0x67, 0x1a, 0x99, 0x01, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x63,
+	0x75, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x48, 0x00, 0x52, 0x05, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x72, 0x69, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x54, 0x72, 0x69, 0x70, 0x48, 0x01, 0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x03, 0x61, 0x76, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x76, 0x67, 0x48, 0x02, 0x52, 0x03, 0x61, 0x76,
+	0x67, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x61, 0x76, 0x67, 0x1a,
+	0x57, 0x0a, 0x04, 0x54, 0x72, 0x69, 0x70, 0x12, 0x11, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x01, 0x61, 0x88, 0x01, 0x01, 0x12, 0x11, 0x0a, 0x01, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x01, 0x62, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x6f, 0x64, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x03, 0x6f, 0x64,
+	0x6f, 0x88, 0x01, 0x01, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x61, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x62,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6f, 0x64, 0x6f, 0x1a, 0x5e, 0x0a, 0x03, 0x41, 0x76, 0x67, 0x12,
+	0x19, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x65, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
+	0x52, 0x0a, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x1a, 0x52, 0x0a, 0x03, 0x4e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0xe0, 0x03, 0x0a, 0x03, 0x49, 0x6d, 0x75,
+	0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x74, 0x68, 0x69, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x01, 0x52, 0x09, 0x61, 0x6e, 0x74, 0x69, 0x54, 0x68, 0x69, 0x65, 0x66, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x69, 0x73, 0x46, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x49, 0x6d, 0x75, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x28, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x49, 0x6d, 0x75, 0x2e,
+	0x54, 0x69, 0x6c, 0x74, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x74, 0x1a, 0xa7, 0x01, 0x0a, 0x05, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x17, 0x0a, 0x04, 0x67, 0x79, 0x72, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52,
+	0x04, 0x67, 0x79, 0x72, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x25, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x67, 0x79, 0x72, 0x6f, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x74, 0x69, 0x6c, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x1a, 0x4d, 0x0a, 0x04, 0x54, 0x69, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x05,
+	0x70, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x70,
+	0x69, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x6c, 0x88, 0x01, 0x01,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x69, 0x74, 0x63, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x74, 0x68, 0x69, 0x65, 0x66, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x1a, 0x58, 0x0a, 0x06, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x06, 0x6e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e,
+	0x65, 0x61, 0x72, 0x62, 0x79, 0x1a, 0x60, 0x0a, 0x06, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12,
+	0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x01, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x1a, 0x79, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x04, 0x6d,
+	0x75, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6d, 0x75, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x1a, 0x58, 0x0a, 0x03, 0x48, 0x6d, 0x69, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x60, 0x0a, 0x08,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xb9,
+	0x04, 0x0a, 0x03, 0x42, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x01, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x6f,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x03, 0x73, 0x6f, 0x63, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x03, 0x52, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x42, 0x6d, 0x73, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x1a, 0xc7, 0x02, 0x0a, 0x04, 0x50,
+	0x61, 0x63, 0x6b, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x07, 0x76, 0x6f, 0x6c,
+	0x74, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x6f, 0x63, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x03, 0x73, 0x6f, 0x63, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x73, 0x6f, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x03, 0x73, 0x6f,
+	0x68, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x0b, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06, 0x52, 0x06, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x63, 0x75,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x6f,
+	0x63, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x6f, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x72, 0x75, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x6f, 0x63, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xbd, 0x0a, 0x0a, 0x03, 0x4d,
+	0x63, 0x75, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x03,
+	0x72, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x48, 0x03, 0x52, 0x09, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x72, 0x70, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x05, 0x52, 0x03, 0x72, 0x70, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x06, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x0b, 0x69, 0x73, 0x4f,
+	0x76, 0x65, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x63,
+	0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x63, 0x75, 0x2e, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x52, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x74,
+	0x6f, 0x72, 0x71, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x63,
+	0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x63, 0x75, 0x2e, 0x54, 0x6f, 0x72,
+	0x71, 0x75, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x72, 0x71, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x64,
+	0x63, 0x5f, 0x62, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x63,
+	0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x63, 0x75, 0x2e, 0x44, 0x63, 0x42,
+	0x75, 0x73, 0x52, 0x05, 0x64, 0x63, 0x42, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x63,
+	0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x63, 0x75, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x3c, 0x0a, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x63, 0x75, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x63, 0x75, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x1a, 0x49, 0x0a, 0x06, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x01, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x72, 0x75, 0x6e, 0x1a, 0x67, 0x0a, 0x06, 0x54,
+	0x6f, 0x72, 0x71, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x1a, 0x5d, 0x0a, 0x05, 0x44, 0x63, 0x42, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00,
+	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52,
+	0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x6f, 0x6c, 0x74,
+	0x61, 0x67, 0x65, 0x1a, 0x84, 0x02, 0x0a, 0x08, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x70, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x70, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x41, 0x0a, 0x0a, 0x64, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x4d, 0x63, 0x75, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x1a, 0x5b, 0x0a, 0x09, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1b, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x63, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x06, 0x64, 0x69, 0x73, 0x63, 0x75, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x74, 0x6f, 0x72, 0x71, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52,
+	0x06, 0x74, 0x6f, 0x72, 0x71, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64,
+	0x69, 0x73, 0x63, 0x75, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x6f, 0x72, 0x71, 0x75, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x70, 0x6d, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x1a, 0x7c, 0x0a, 0x07, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x0a, 0x64, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x48, 0x00, 0x52, 0x09, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x72, 0x75, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x72, 0x70, 0x6d, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x73, 0x5f,
+	0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x1a, 0xa1, 0x04, 0x0a, 0x04, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x12, 0x2e, 0x0a, 0x06, 0x77, 0x61, 0x6b, 0x65, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x06, 0x77, 0x61, 0x6b, 0x65, 0x75,
+	0x70, 0x1a, 0xba, 0x03, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x69, 0x6d, 0x75,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x03, 0x69, 0x6d, 0x75, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x05, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06, 0x52,
+	0x06, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x07, 0x52, 0x05, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x67, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x08, 0x52, 0x04, 0x67, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a,
+	0x0a, 0x06, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09,
+	0x52, 0x05, 0x63, 0x61, 0x6e, 0x52, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x63, 0x61,
+	0x6e, 0x5f, 0x74, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0a, 0x52, 0x05, 0x63, 0x61,
+	0x6e, 0x54, 0x78, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x69, 0x6d, 0x75, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x61, 0x6e,
+	0x5f, 0x72, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x61, 0x6e, 0x5f, 0x74, 0x78, 0x22, 0x1e,
+	0x0a, 0x0a, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x22, 0x31,
+	0x0a, 0x0d, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f,
+	0x6e, 0x22, 0x4e, 0x0a, 0x0a, 0x52, 0x74, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69,
+	0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x4a, 0x0a, 0x10, 0x42, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x42, 0x69, 0x6b,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a,
+	0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76,
+	0x69, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x42, 0x0a, 0x0c, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x05, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x76, 0x63, 0x75,
+	0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a,
+	0x10, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x76, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0b, 0x55, 0x73, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x76, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x73, 0x64, 0x22, 0x57, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x70,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12, 0x21,
+	0x0a, 0x04, 0x74, 0x72, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x70, 0x52, 0x04, 0x74, 0x72, 0x69,
+	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x6b,
+	0x6d, 0x22, 0x46, 0x0a, 0x0c, 0x44, 0x72, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x76, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x64, 0x72, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x52, 0x05, 0x64, 0x72, 0x69, 0x76, 0x65, 0x22, 0x42, 0x0a, 0x0b, 0x54, 0x72, 0x69,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x72,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x70, 0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x22, 0x3e, 0x0a,
+	0x0a, 0x41, 0x76, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12, 0x1e, 0x0a,
+	0x03, 0x61, 0x76, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x76, 0x63, 0x75,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x76, 0x67, 0x52, 0x03, 0x61, 0x76, 0x67, 0x22, 0x4e, 0x0a,
+	0x0f, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76,
+	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x70, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x6b, 0x70, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x64, 0x0a,
+	0x13, 0x53, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0b, 0x4d, 0x63, 0x75, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x63, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x64, 0x69, 0x73, 0x63, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x72, 0x71, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x6f, 0x72, 0x71,
+	0x75, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x63,
+	0x75, 0x2e, 0x4d, 0x63, 0x75, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x24, 0x0a, 0x10, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0x20, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69,
+	0x64, 0x2a, 0x3c, 0x0a, 0x05, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x02, 0x2a,
+	0xc3, 0x01, 0x0a, 0x09, 0x42, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a,
+	0x11, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d,
+	0x41, 0x4c, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x12, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xfd, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x1c, 0x0a, 0x0f, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x12, 0x1e, 0x0a, 0x11, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x42, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x42,
+	0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x52, 0x55, 0x4e, 0x10, 0x03, 0x2a, 0x52, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45,
+	0x5f, 0x45, 0x43, 0x4f, 0x4e, 0x4f, 0x4d, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52,
+	0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x56,
+	0x45, 0x5f, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x2a, 0x3f, 0x0a, 0x08, 0x4d, 0x6f, 0x64,
+	0x65, 0x54, 0x72, 0x69, 0x70, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x52,
+	0x49, 0x50, 0x5f, 0x4f, 0x44, 0x4f, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x41, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x42, 0x10, 0x02, 0x2a, 0x36, 0x0a, 0x07, 0x4d, 0x6f,
+	0x64, 0x65, 0x41, 0x76, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x56,
+	0x47, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x41, 0x56, 0x47, 0x5f, 0x45, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x43, 0x59,
+	0x10, 0x01, 0x32, 0xb6, 0x0d, 0x0a, 0x03, 0x56, 0x63, 0x75, 0x12, 0x34, 0x0a, 0x0b, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x30, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x30, 0x01, 0x12, 0x2a, 0x0a, 0x07, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0f, 0x2e,
+	0x76, 0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x76, 0x63, 0x75, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f,
+	0x0a, 0x06, 0x47, 0x65, 0x6e, 0x4c, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2c, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x52, 0x74, 0x63, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x52, 0x74, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x42, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e,
+	0x76, 0x63, 0x75, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a,
+	0x0b, 0x47, 0x65, 0x6e, 0x43, 0x61, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x12, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x75,
+	0x73, 0x68, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x0e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14,
+	0x2e, 0x76, 0x63, 0x75, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x09,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x42, 0x65, 0x65, 0x70, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a,
+	0x0b, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x0f, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x76, 0x63, 0x75, 0x2e, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x46, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63,
+	0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f,
+	0x0a, 0x09, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x76, 0x63,
+	0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x33, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x65,
+	0x61, 0x74, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x46, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07,
+	0x46, 0x6f, 0x74, 0x61, 0x56, 0x63, 0x75, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x46, 0x6f, 0x74, 0x61,
+	0x48, 0x6d, 0x69, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x55,
+	0x73, 0x73, 0x64, 0x12, 0x10, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x55, 0x73, 0x73, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64,
+	0x53, 0x6d, 0x73, 0x12, 0x0f, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x56, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x48, 0x62, 0x61, 0x72, 0x54, 0x72, 0x69, 0x70,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x54, 0x72, 0x69, 0x70,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x31, 0x0a, 0x09, 0x48, 0x62, 0x61, 0x72, 0x44, 0x72, 0x69, 0x76, 0x65, 0x12, 0x11, 0x2e, 0x76,
+	0x63, 0x75, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x48, 0x62, 0x61, 0x72, 0x54, 0x72, 0x69, 0x70, 0x12, 0x10,
+	0x2e, 0x76, 0x63, 0x75, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x48, 0x62, 0x61, 0x72, 0x41, 0x76, 0x67, 0x12, 0x0f,
+	0x2e, 0x76, 0x63, 0x75, 0x2e, 0x41, 0x76, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x4d, 0x63, 0x75, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4d, 0x61,
+	0x78, 0x12, 0x14, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0f, 0x4d, 0x63,
+	0x75, 0x53, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x2e,
+	0x76, 0x63, 0x75, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x4d, 0x63,
+	0x75, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x76, 0x63, 0x75,
+	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x0c, 0x49, 0x6d, 0x75, 0x41, 0x6e, 0x74, 0x69, 0x54,
+	0x68, 0x69, 0x65, 0x66, 0x12, 0x12, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x2d, 0x5a, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x64, 0x61, 0x2d,
+	0x65, 0x6e, 0x65, 0x72, 0x67, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2e, 0x76, 0x63, 0x75, 0x2e, 0x73,
+	0x64, 0x6b, 0x2f, 0x72, 0x70, 0x63, 0x3b, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_vcu_proto_rawDescOnce sync.Once
+	file_vcu_proto_rawDescData = file_vcu_proto_rawDesc
+)
+
+func file_vcu_proto_rawDescGZIP() []byte {
+	file_vcu_proto_rawDescOnce.Do(func() {
+		file_vcu_proto_rawDescData = protoimpl.X.CompressGZIP(file_vcu_proto_rawDescData)
+	})
+	return file_vcu_proto_rawDescData
+}
+
+var file_vcu_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_vcu_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_vcu_proto_goTypes = []interface{}{
+	(Frame)(0),                            // 0: vcu.Frame
+	(BikeState)(0),                        // 1: vcu.BikeState
+	(ModeDrive)(0),                        // 2: vcu.ModeDrive
+	(ModeTrip)(0),                         // 3: vcu.ModeTrip
+	(ModeAvg)(0),                          // 4: vcu.ModeAvg
+	(*WatchRequest)(nil),                  // 5: vcu.WatchRequest
+	(*StatusEvent)(nil),                   // 6: vcu.StatusEvent
+	(*Report)(nil),                        // 7: vcu.Report
+	(*VinRequest)(nil),                    // 8: vcu.VinRequest
+	(*SwitchRequest)(nil),                 // 9: vcu.SwitchRequest
+	(*RtcRequest)(nil),                    // 10: vcu.RtcRequest
+	(*BikeStateRequest)(nil),              // 11: vcu.BikeStateRequest
+	(*IntervalRequest)(nil),               // 12: vcu.IntervalRequest
+	(*FrameRequest)(nil),                  // 13: vcu.FrameRequest
+	(*FingerDelRequest)(nil),              // 14: vcu.FingerDelRequest
+	(*UssdRequest)(nil),                   // 15: vcu.UssdRequest
+	(*TripMeterRequest)(nil),              // 16: vcu.TripMeterRequest
+	(*DriveRequest)(nil),                  // 17: vcu.DriveRequest
+	(*TripRequest)(nil),                   // 18: vcu.TripRequest
+	(*AvgRequest)(nil),                    // 19: vcu.AvgRequest
+	(*SpeedMaxRequest)(nil),               // 20: vcu.SpeedMaxRequest
+	(*SetDriveModeRequest)(nil),           // 21: vcu.SetDriveModeRequest
+	(*McuTemplate)(nil),                   // 22: vcu.McuTemplate
+	(*TemplatesRequest)(nil),              // 23: vcu.TemplatesRequest
+	(*CommandReply)(nil),                  // 24: vcu.CommandReply
+	(*TextReply)(nil),                     // 25: vcu.TextReply
+	(*FingerFetchReply)(nil),              // 26: vcu.FingerFetchReply
+	(*FingerAddReply)(nil),                // 27: vcu.FingerAddReply
+	(*Report_Meta)(nil),                   // 28: vcu.Report.Meta
+	(*Report_Vcu)(nil),                    // 29: vcu.Report.Vcu
+	(*Report_Eeprom)(nil),                 // 30: vcu.Report.Eeprom
+	(*Report_Gps)(nil),                    // 31: vcu.Report.Gps
+	(*Report_Hbar)(nil),                   // 32: vcu.Report.Hbar
+	(*Report_Net)(nil),                    // 33: vcu.Report.Net
+	(*Report_Imu)(nil),                    // 34: vcu.Report.Imu
+	(*Report_Remote)(nil),                 // 35: vcu.Report.Remote
+	(*Report_Finger)(nil),                 // 36: vcu.Report.Finger
+	(*Report_Audio)(nil),                  // 37: vcu.Report.Audio
+	(*Report_Hmi)(nil),                    // 38: vcu.Report.Hmi
+	(*Report_Capacity)(nil),               // 39: vcu.Report.Capacity
+	(*Report_Bms)(nil),                    // 40: vcu.Report.Bms
+	(*Report_Mcu)(nil),                    // 41: vcu.Report.Mcu
+	(*Report_Task)(nil),                   // 42: vcu.Report.Task
+	(*Report_Hbar_Mode)(nil),              // 43: vcu.Report.Hbar.Mode
+	(*Report_Hbar_Trip)(nil),              // 44: vcu.Report.Hbar.Trip
+	(*Report_Hbar_Avg)(nil),               // 45: vcu.Report.Hbar.Avg
+	(*Report_Imu_Total)(nil),              // 46: vcu.Report.Imu.Total
+	(*Report_Imu_Tilt)(nil),               // 47: vcu.Report.Imu.Tilt
+	(*Report_Bms_Pack)(nil),               // 48: vcu.Report.Bms.Pack
+	(*Report_Mcu_Faults)(nil),             // 49: vcu.Report.Mcu.Faults
+	(*Report_Mcu_Torque)(nil),             // 50: vcu.Report.Mcu.Torque
+	(*Report_Mcu_DcBus)(nil),              // 51: vcu.Report.Mcu.DcBus
+	(*Report_Mcu_Template)(nil),           // 52: vcu.Report.Mcu.Template
+	(*Report_Mcu_Setting)(nil),            // 53: vcu.Report.Mcu.Setting
+	(*Report_Mcu_Template_DriveMode)(nil), // 54: vcu.Report.Mcu.Template.DriveMode
+	(*Report_Task_Tasks)(nil),             // 55: vcu.Report.Task.Tasks
+	(*timestamppb.Timestamp)(nil),         // 56: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),           // 57: google.protobuf.Duration
+}
+var file_vcu_proto_depIdxs = []int32{
+	56, // 0: vcu.StatusEvent.time:type_name -> google.protobuf.Timestamp
+	28, // 1: vcu.Report.report:type_name -> vcu.Report.Meta
+	29, // 2: vcu.Report.vcu:type_name -> vcu.Report.Vcu
+	30, // 3: vcu.Report.eeprom:type_name -> vcu.Report.Eeprom
+	31, // 4: vcu.Report.gps:type_name -> vcu.Report.Gps
+	32, // 5: vcu.Report.hbar:type_name -> vcu.Report.Hbar
+	33, // 6: vcu.Report.net:type_name -> vcu.Report.Net
+	34, // 7: vcu.Report.imu:type_name -> vcu.Report.Imu
+	35, // 8: vcu.Report.remote:type_name -> vcu.Report.Remote
+	36, // 9: vcu.Report.finger:type_name -> vcu.Report.Finger
+	37, // 10: vcu.Report.audio:type_name -> vcu.Report.Audio
+	38, // 11: vcu.Report.hmi:type_name -> vcu.Report.Hmi
+	40, // 12: vcu.Report.bms:type_name -> vcu.Report.Bms
+	41, // 13: vcu.Report.mcu:type_name -> vcu.Report.Mcu
+	42, // 14: vcu.Report.task:type_name -> vcu.Report.Task
+	56, // 15: vcu.RtcRequest.time:type_name -> google.protobuf.Timestamp
+	1,  // 16: vcu.BikeStateRequest.state:type_name -> vcu.BikeState
+	57, // 17: vcu.IntervalRequest.interval:type_name -> google.protobuf.Duration
+	0,  // 18: vcu.FrameRequest.frame:type_name -> vcu.Frame
+	3,  // 19: vcu.TripMeterRequest.trip:type_name -> vcu.ModeTrip
+	2,  // 20: vcu.DriveRequest.drive:type_name -> vcu.ModeDrive
+	3,  // 21: vcu.TripRequest.trip:type_name -> vcu.ModeTrip
+	4,  // 22: vcu.AvgRequest.avg:type_name -> vcu.ModeAvg
+	2,  // 23: vcu.SetDriveModeRequest.mode:type_name -> vcu.ModeDrive
+	22, // 24: vcu.TemplatesRequest.templates:type_name -> vcu.McuTemplate
+	56, // 25: vcu.Report.Meta.send_datetime:type_name -> google.protobuf.Timestamp
+	56, // 26: vcu.Report.Meta.log_datetime:type_name -> google.protobuf.Timestamp
+	0,  // 27: vcu.Report.Meta.frame:type_name -> vcu.Frame
+	1,  // 28: vcu.Report.Vcu.state:type_name -> vcu.BikeState
+	43, // 29: vcu.Report.Hbar.mode:type_name -> vcu.Report.Hbar.Mode
+	44, // 30: vcu.Report.Hbar.trip:type_name -> vcu.Report.Hbar.Trip
+	45, // 31: vcu.Report.Hbar.avg:type_name -> vcu.Report.Hbar.Avg
+	46, // 32: vcu.Report.Imu.total:type_name -> vcu.Report.Imu.Total
+	47, // 33: vcu.Report.Imu.tilt:type_name -> vcu.Report.Imu.Tilt
+	39, // 34: vcu.Report.Bms.capacity:type_name -> vcu.Report.Capacity
+	48, // 35: vcu.Report.Bms.pack:type_name -> vcu.Report.Bms.Pack
+	2,  // 36: vcu.Report.Mcu.drive_mode:type_name -> vcu.ModeDrive
+	49, // 37: vcu.Report.Mcu.faults:type_name -> vcu.Report.Mcu.Faults
+	50, // 38: vcu.Report.Mcu.torque:type_name -> vcu.Report.Mcu.Torque
+	51, // 39: vcu.Report.Mcu.dc_bus:type_name -> vcu.Report.Mcu.DcBus
+	52, // 40: vcu.Report.Mcu.template:type_name -> vcu.Report.Mcu.Template
+	53, // 41: vcu.Report.Mcu.template_list:type_name -> vcu.Report.Mcu.Setting
+	53, // 42: vcu.Report.Mcu.setting:type_name -> vcu.Report.Mcu.Setting
+	55, // 43: vcu.Report.Task.stack:type_name -> vcu.Report.Task.Tasks
+	55, // 44: vcu.Report.Task.wakeup:type_name -> vcu.Report.Task.Tasks
+	2,  // 45: vcu.Report.Hbar.Mode.drive:type_name -> vcu.ModeDrive
+	3,  // 46: vcu.Report.Hbar.Mode.trip:type_name -> vcu.ModeTrip
+	4,  // 47: vcu.Report.Hbar.Mode.avg:type_name -> vcu.ModeAvg
+	39, // 48: vcu.Report.Bms.Pack.capacity:type_name -> vcu.Report.Capacity
+	54, // 49: vcu.Report.Mcu.Template.drive_mode:type_name -> vcu.Report.Mcu.Template.DriveMode
+	2,  // 50: vcu.Report.Mcu.Setting.drive_mode:type_name -> vcu.ModeDrive
+	5,  // 51: vcu.Vcu.WatchStatus:input_type -> vcu.WatchRequest
+	5,  // 52: vcu.Vcu.WatchReports:input_type -> vcu.WatchRequest
+	8,  // 53: vcu.Vcu.GenInfo:input_type -> vcu.VinRequest
+	9,  // 54: vcu.Vcu.GenLed:input_type -> vcu.SwitchRequest
+	10, // 55: vcu.Vcu.GenRtc:input_type -> vcu.RtcRequest
+	11, // 56: vcu.Vcu.GenBikeState:input_type -> vcu.BikeStateRequest
+	9,  // 57: vcu.Vcu.GenLockDown:input_type -> vcu.SwitchRequest
+	9,  // 58: vcu.Vcu.GenCanDebug:input_type -> vcu.SwitchRequest
+	8,  // 59: vcu.Vcu.ReportFlush:input_type -> vcu.VinRequest
+	9,  // 60: vcu.Vcu.ReportBlock:input_type -> vcu.SwitchRequest
+	12, // 61: vcu.Vcu.ReportInterval:input_type -> vcu.IntervalRequest
+	13, // 62: vcu.Vcu.ReportFrame:input_type -> vcu.FrameRequest
+	8,  // 63: vcu.Vcu.AudioBeep:input_type -> vcu.VinRequest
+	8,  // 64: vcu.Vcu.FingerFetch:input_type -> vcu.VinRequest
+	8,  // 65: vcu.Vcu.FingerAdd:input_type -> vcu.VinRequest
+	14, // 66: vcu.Vcu.FingerDel:input_type -> vcu.FingerDelRequest
+	8,  // 67: vcu.Vcu.FingerRst:input_type -> vcu.VinRequest
+	8,  // 68: vcu.Vcu.RemotePairing:input_type -> vcu.VinRequest
+	8,  // 69: vcu.Vcu.RemoteSeat:input_type -> vcu.VinRequest
+	8,  // 70: vcu.Vcu.RemoteAlarm:input_type -> vcu.VinRequest
+	8,  // 71: vcu.Vcu.FotaRestart:input_type -> vcu.VinRequest
+	8,  // 72: vcu.Vcu.FotaVcu:input_type -> vcu.VinRequest
+	8,  // 73: vcu.Vcu.FotaHmi:input_type -> vcu.VinRequest
+	15, // 74: vcu.Vcu.NetSendUssd:input_type -> vcu.UssdRequest
+	8,  // 75: vcu.Vcu.NetReadSms:input_type -> vcu.VinRequest
+	16, // 76: vcu.Vcu.HbarTripMeter:input_type -> vcu.TripMeterRequest
+	17, // 77: vcu.Vcu.HbarDrive:input_type -> vcu.DriveRequest
+	18, // 78: vcu.Vcu.HbarTrip:input_type -> vcu.TripRequest
+	19, // 79: vcu.Vcu.HbarAvg:input_type -> vcu.AvgRequest
+	20, // 80: vcu.Vcu.McuSpeedMax:input_type -> vcu.SpeedMaxRequest
+	21, // 81: vcu.Vcu.McuSetDriveMode:input_type -> vcu.SetDriveModeRequest
+	23, // 82: vcu.Vcu.McuTemplates:input_type -> vcu.TemplatesRequest
+	9,  // 83: vcu.Vcu.ImuAntiThief:input_type -> vcu.SwitchRequest
+	6,  // 84: vcu.Vcu.WatchStatus:output_type -> vcu.StatusEvent
+	7,  // 85: vcu.Vcu.WatchReports:output_type -> vcu.Report
+	25, // 86: vcu.Vcu.GenInfo:output_type -> vcu.TextReply
+	24, // 87: vcu.Vcu.GenLed:output_type -> vcu.CommandReply
+	24, // 88: vcu.Vcu.GenRtc:output_type -> vcu.CommandReply
+	24, // 89: vcu.Vcu.GenBikeState:output_type -> vcu.CommandReply
+	24, // 90: vcu.Vcu.GenLockDown:output_type -> vcu.CommandReply
+	24, // 91: vcu.Vcu.GenCanDebug:output_type -> vcu.CommandReply
+	24, // 92: vcu.Vcu.ReportFlush:output_type -> vcu.CommandReply
+	24, // 93: vcu.Vcu.ReportBlock:output_type -> vcu.CommandReply
+	24, // 94: vcu.Vcu.ReportInterval:output_type -> vcu.CommandReply
+	24, // 95: vcu.Vcu.ReportFrame:output_type -> vcu.CommandReply
+	24, // 96: vcu.Vcu.AudioBeep:output_type -> vcu.CommandReply
+	26, // 97: vcu.Vcu.FingerFetch:output_type -> vcu.FingerFetchReply
+	27, // 98: vcu.Vcu.FingerAdd:output_type -> vcu.FingerAddReply
+	24, // 99: vcu.Vcu.FingerDel:output_type -> vcu.CommandReply
+	24, // 100: vcu.Vcu.FingerRst:output_type -> vcu.CommandReply
+	24, // 101: vcu.Vcu.RemotePairing:output_type -> vcu.CommandReply
+	24, // 102: vcu.Vcu.RemoteSeat:output_type -> vcu.CommandReply
+	24, // 103: vcu.Vcu.RemoteAlarm:output_type -> vcu.CommandReply
+	24, // 104: vcu.Vcu.FotaRestart:output_type -> vcu.CommandReply
+	25, // 105: vcu.Vcu.FotaVcu:output_type -> vcu.TextReply
+	25, // 106: vcu.Vcu.FotaHmi:output_type -> vcu.TextReply
+	25, // 107: vcu.Vcu.NetSendUssd:output_type -> vcu.TextReply
+	25, // 108: vcu.Vcu.NetReadSms:output_type -> vcu.TextReply
+	24, // 109: vcu.Vcu.HbarTripMeter:output_type -> vcu.CommandReply
+	24, // 110: vcu.Vcu.HbarDrive:output_type -> vcu.CommandReply
+	24, // 111: vcu.Vcu.HbarTrip:output_type -> vcu.CommandReply
+	24, // 112: vcu.Vcu.HbarAvg:output_type -> vcu.CommandReply
+	24, // 113: vcu.Vcu.McuSpeedMax:output_type -> vcu.CommandReply
+	24, // 114: vcu.Vcu.McuSetDriveMode:output_type -> vcu.CommandReply
+	24, // 115: vcu.Vcu.McuTemplates:output_type -> vcu.CommandReply
+	24, // 116: vcu.Vcu.ImuAntiThief:output_type -> vcu.CommandReply
+	84, // [84:117] is the sub-list for method output_type
+	51, // [51:84] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
+}
+
+func init() { file_vcu_proto_init() }
+func file_vcu_proto_init() {
+	if File_vcu_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_vcu_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RtcRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BikeStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntervalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FingerDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UssdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripMeterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DriveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpeedMaxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDriveModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*McuTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FingerFetchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FingerAddReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Meta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Vcu); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Eeprom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Gps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Hbar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Net); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Imu); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Remote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Finger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Audio); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Hmi); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Capacity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Bms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Mcu); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Task); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Hbar_Mode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Hbar_Trip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Hbar_Avg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Imu_Total); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Imu_Tilt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Bms_Pack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Mcu_Faults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Mcu_Torque); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Mcu_DcBus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Mcu_Template); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Mcu_Setting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Mcu_Template_DriveMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vcu_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report_Task_Tasks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_vcu_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[39].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[43].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[44].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[45].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[47].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_vcu_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vcu_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   51,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vcu_proto_goTypes,
+		DependencyIndexes: file_vcu_proto_depIdxs,
+		EnumInfos:         file_vcu_proto_enumTypes,
+		MessageInfos:      file_vcu_proto_msgTypes,
+	}.Build()
+	File_vcu_proto = out.File
+	file_vcu_proto_rawDesc = nil
+	file_vcu_proto_goTypes = nil
+	file_vcu_proto_depIdxs = nil
+}
@@ -0,0 +1,1354 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.23.4
+// source: vcu.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Vcu_WatchStatus_FullMethodName     = "/vcu.Vcu/WatchStatus"
+	Vcu_WatchReports_FullMethodName    = "/vcu.Vcu/WatchReports"
+	Vcu_GenInfo_FullMethodName         = "/vcu.Vcu/GenInfo"
+	Vcu_GenLed_FullMethodName          = "/vcu.Vcu/GenLed"
+	Vcu_GenRtc_FullMethodName          = "/vcu.Vcu/GenRtc"
+	Vcu_GenBikeState_FullMethodName    = "/vcu.Vcu/GenBikeState"
+	Vcu_GenLockDown_FullMethodName     = "/vcu.Vcu/GenLockDown"
+	Vcu_GenCanDebug_FullMethodName     = "/vcu.Vcu/GenCanDebug"
+	Vcu_ReportFlush_FullMethodName     = "/vcu.Vcu/ReportFlush"
+	Vcu_ReportBlock_FullMethodName     = "/vcu.Vcu/ReportBlock"
+	Vcu_ReportInterval_FullMethodName  = "/vcu.Vcu/ReportInterval"
+	Vcu_ReportFrame_FullMethodName     = "/vcu.Vcu/ReportFrame"
+	Vcu_AudioBeep_FullMethodName       = "/vcu.Vcu/AudioBeep"
+	Vcu_FingerFetch_FullMethodName     = "/vcu.Vcu/FingerFetch"
+	Vcu_FingerAdd_FullMethodName       = "/vcu.Vcu/FingerAdd"
+	Vcu_FingerDel_FullMethodName       = "/vcu.Vcu/FingerDel"
+	Vcu_FingerRst_FullMethodName       = "/vcu.Vcu/FingerRst"
+	Vcu_RemotePairing_FullMethodName   = "/vcu.Vcu/RemotePairing"
+	Vcu_RemoteSeat_FullMethodName      = "/vcu.Vcu/RemoteSeat"
+	Vcu_RemoteAlarm_FullMethodName     = "/vcu.Vcu/RemoteAlarm"
+	Vcu_FotaRestart_FullMethodName     = "/vcu.Vcu/FotaRestart"
+	Vcu_FotaVcu_FullMethodName         = "/vcu.Vcu/FotaVcu"
+	Vcu_FotaHmi_FullMethodName         = "/vcu.Vcu/FotaHmi"
+	Vcu_NetSendUssd_FullMethodName     = "/vcu.Vcu/NetSendUssd"
+	Vcu_NetReadSms_FullMethodName      = "/vcu.Vcu/NetReadSms"
+	Vcu_HbarTripMeter_FullMethodName   = "/vcu.Vcu/HbarTripMeter"
+	Vcu_HbarDrive_FullMethodName       = "/vcu.Vcu/HbarDrive"
+	Vcu_HbarTrip_FullMethodName        = "/vcu.Vcu/HbarTrip"
+	Vcu_HbarAvg_FullMethodName         = "/vcu.Vcu/HbarAvg"
+	Vcu_McuSpeedMax_FullMethodName     = "/vcu.Vcu/McuSpeedMax"
+	Vcu_McuSetDriveMode_FullMethodName = "/vcu.Vcu/McuSetDriveMode"
+	Vcu_McuTemplates_FullMethodName    = "/vcu.Vcu/McuTemplates"
+	Vcu_ImuAntiThief_FullMethodName    = "/vcu.Vcu/ImuAntiThief"
+)
+
+// VcuClient is the client API for Vcu service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VcuClient interface {
+	// WatchStatus stream online status of vins (all if empty).
+	WatchStatus(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Vcu_WatchStatusClient, error)
+	// WatchReports stream reports of vins (all if empty),
+	// projected to fields when specified.
+	WatchReports(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Vcu_WatchReportsClient, error)
+	GenInfo(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*TextReply, error)
+	GenLed(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	GenRtc(ctx context.Context, in *RtcRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	GenBikeState(ctx context.Context, in *BikeStateRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	GenLockDown(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	GenCanDebug(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	ReportFlush(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	ReportBlock(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	ReportInterval(ctx context.Context, in *IntervalRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	ReportFrame(ctx context.Context, in *FrameRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	AudioBeep(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	FingerFetch(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*FingerFetchReply, error)
+	FingerAdd(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*FingerAddReply, error)
+	FingerDel(ctx context.Context, in *FingerDelRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	FingerRst(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	RemotePairing(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	RemoteSeat(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	RemoteAlarm(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	FotaRestart(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	FotaVcu(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*TextReply, error)
+	FotaHmi(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*TextReply, error)
+	NetSendUssd(ctx context.Context, in *UssdRequest, opts ...grpc.CallOption) (*TextReply, error)
+	NetReadSms(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*TextReply, error)
+	HbarTripMeter(ctx context.Context, in *TripMeterRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	HbarDrive(ctx context.Context, in *DriveRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	HbarTrip(ctx context.Context, in *TripRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	HbarAvg(ctx context.Context, in *AvgRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	McuSpeedMax(ctx context.Context, in *SpeedMaxRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	McuSetDriveMode(ctx context.Context, in *SetDriveModeRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	McuTemplates(ctx context.Context, in *TemplatesRequest, opts ...grpc.CallOption) (*CommandReply, error)
+	ImuAntiThief(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error)
+}
+
+type vcuClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVcuClient(cc grpc.ClientConnInterface) VcuClient {
+	return &vcuClient{cc}
+}
+
+func (c *vcuClient) WatchStatus(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Vcu_WatchStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Vcu_ServiceDesc.Streams[0], Vcu_WatchStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vcuWatchStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vcu_WatchStatusClient interface {
+	Recv() (*StatusEvent, error)
+	grpc.ClientStream
+}
+
+type vcuWatchStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *vcuWatchStatusClient) Recv() (*StatusEvent, error) {
+	m := new(StatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vcuClient) WatchReports(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Vcu_WatchReportsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Vcu_ServiceDesc.Streams[1], Vcu_WatchReports_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vcuWatchReportsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vcu_WatchReportsClient interface {
+	Recv() (*Report, error)
+	grpc.ClientStream
+}
+
+type vcuWatchReportsClient struct {
+	grpc.ClientStream
+}
+
+func (x *vcuWatchReportsClient) Recv() (*Report, error) {
+	m := new(Report)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vcuClient) GenInfo(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*TextReply, error) {
+	out := new(TextReply)
+	err := c.cc.Invoke(ctx, Vcu_GenInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) GenLed(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_GenLed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) GenRtc(ctx context.Context, in *RtcRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_GenRtc_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) GenBikeState(ctx context.Context, in *BikeStateRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_GenBikeState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) GenLockDown(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_GenLockDown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) GenCanDebug(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_GenCanDebug_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) ReportFlush(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_ReportFlush_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) ReportBlock(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_ReportBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) ReportInterval(ctx context.Context, in *IntervalRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_ReportInterval_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) ReportFrame(ctx context.Context, in *FrameRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_ReportFrame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) AudioBeep(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_AudioBeep_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) FingerFetch(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*FingerFetchReply, error) {
+	out := new(FingerFetchReply)
+	err := c.cc.Invoke(ctx, Vcu_FingerFetch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) FingerAdd(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*FingerAddReply, error) {
+	out := new(FingerAddReply)
+	err := c.cc.Invoke(ctx, Vcu_FingerAdd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) FingerDel(ctx context.Context, in *FingerDelRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_FingerDel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) FingerRst(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_FingerRst_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) RemotePairing(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_RemotePairing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) RemoteSeat(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_RemoteSeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) RemoteAlarm(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_RemoteAlarm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) FotaRestart(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_FotaRestart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) FotaVcu(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*TextReply, error) {
+	out := new(TextReply)
+	err := c.cc.Invoke(ctx, Vcu_FotaVcu_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) FotaHmi(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*TextReply, error) {
+	out := new(TextReply)
+	err := c.cc.Invoke(ctx, Vcu_FotaHmi_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) NetSendUssd(ctx context.Context, in *UssdRequest, opts ...grpc.CallOption) (*TextReply, error) {
+	out := new(TextReply)
+	err := c.cc.Invoke(ctx, Vcu_NetSendUssd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) NetReadSms(ctx context.Context, in *VinRequest, opts ...grpc.CallOption) (*TextReply, error) {
+	out := new(TextReply)
+	err := c.cc.Invoke(ctx, Vcu_NetReadSms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) HbarTripMeter(ctx context.Context, in *TripMeterRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_HbarTripMeter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) HbarDrive(ctx context.Context, in *DriveRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_HbarDrive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) HbarTrip(ctx context.Context, in *TripRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_HbarTrip_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) HbarAvg(ctx context.Context, in *AvgRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_HbarAvg_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) McuSpeedMax(ctx context.Context, in *SpeedMaxRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_McuSpeedMax_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) McuSetDriveMode(ctx context.Context, in *SetDriveModeRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_McuSetDriveMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) McuTemplates(ctx context.Context, in *TemplatesRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_McuTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcuClient) ImuAntiThief(ctx context.Context, in *SwitchRequest, opts ...grpc.CallOption) (*CommandReply, error) {
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Vcu_ImuAntiThief_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VcuServer is the server API for Vcu service.
+// All implementations must embed UnimplementedVcuServer
+// for forward compatibility
+type VcuServer interface {
+	// WatchStatus stream online status of vins (all if empty).
+	WatchStatus(*WatchRequest, Vcu_WatchStatusServer) error
+	// WatchReports stream reports of vins (all if empty),
+	// projected to fields when specified.
+	WatchReports(*WatchRequest, Vcu_WatchReportsServer) error
+	GenInfo(context.Context, *VinRequest) (*TextReply, error)
+	GenLed(context.Context, *SwitchRequest) (*CommandReply, error)
+	GenRtc(context.Context, *RtcRequest) (*CommandReply, error)
+	GenBikeState(context.Context, *BikeStateRequest) (*CommandReply, error)
+	GenLockDown(context.Context, *SwitchRequest) (*CommandReply, error)
+	GenCanDebug(context.Context, *SwitchRequest) (*CommandReply, error)
+	ReportFlush(context.Context, *VinRequest) (*CommandReply, error)
+	ReportBlock(context.Context, *SwitchRequest) (*CommandReply, error)
+	ReportInterval(context.Context, *IntervalRequest) (*CommandReply, error)
+	ReportFrame(context.Context, *FrameRequest) (*CommandReply, error)
+	AudioBeep(context.Context, *VinRequest) (*CommandReply, error)
+	FingerFetch(context.Context, *VinRequest) (*FingerFetchReply, error)
+	FingerAdd(context.Context, *VinRequest) (*FingerAddReply, error)
+	FingerDel(context.Context, *FingerDelRequest) (*CommandReply, error)
+	FingerRst(context.Context, *VinRequest) (*CommandReply, error)
+	RemotePairing(context.Context, *VinRequest) (*CommandReply, error)
+	RemoteSeat(context.Context, *VinRequest) (*CommandReply, error)
+	RemoteAlarm(context.Context, *VinRequest) (*CommandReply, error)
+	FotaRestart(context.Context, *VinRequest) (*CommandReply, error)
+	FotaVcu(context.Context, *VinRequest) (*TextReply, error)
+	FotaHmi(context.Context, *VinRequest) (*TextReply, error)
+	NetSendUssd(context.Context, *UssdRequest) (*TextReply, error)
+	NetReadSms(context.Context, *VinRequest) (*TextReply, error)
+	HbarTripMeter(context.Context, *TripMeterRequest) (*CommandReply, error)
+	HbarDrive(context.Context, *DriveRequest) (*CommandReply, error)
+	HbarTrip(context.Context, *TripRequest) (*CommandReply, error)
+	HbarAvg(context.Context, *AvgRequest) (*CommandReply, error)
+	McuSpeedMax(context.Context, *SpeedMaxRequest) (*CommandReply, error)
+	McuSetDriveMode(context.Context, *SetDriveModeRequest) (*CommandReply, error)
+	McuTemplates(context.Context, *TemplatesRequest) (*CommandReply, error)
+	ImuAntiThief(context.Context, *SwitchRequest) (*CommandReply, error)
+	mustEmbedUnimplementedVcuServer()
+}
+
+// UnimplementedVcuServer must be embedded to have forward compatible implementations.
+type UnimplementedVcuServer struct {
+}
+
+func (UnimplementedVcuServer) WatchStatus(*WatchRequest, Vcu_WatchStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatus not implemented")
+}
+func (UnimplementedVcuServer) WatchReports(*WatchRequest, Vcu_WatchReportsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReports not implemented")
+}
+func (UnimplementedVcuServer) GenInfo(context.Context, *VinRequest) (*TextReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenInfo not implemented")
+}
+func (UnimplementedVcuServer) GenLed(context.Context, *SwitchRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenLed not implemented")
+}
+func (UnimplementedVcuServer) GenRtc(context.Context, *RtcRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenRtc not implemented")
+}
+func (UnimplementedVcuServer) GenBikeState(context.Context, *BikeStateRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenBikeState not implemented")
+}
+func (UnimplementedVcuServer) GenLockDown(context.Context, *SwitchRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenLockDown not implemented")
+}
+func (UnimplementedVcuServer) GenCanDebug(context.Context, *SwitchRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenCanDebug not implemented")
+}
+func (UnimplementedVcuServer) ReportFlush(context.Context, *VinRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportFlush not implemented")
+}
+func (UnimplementedVcuServer) ReportBlock(context.Context, *SwitchRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportBlock not implemented")
+}
+func (UnimplementedVcuServer) ReportInterval(context.Context, *IntervalRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportInterval not implemented")
+}
+func (UnimplementedVcuServer) ReportFrame(context.Context, *FrameRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportFrame not implemented")
+}
+func (UnimplementedVcuServer) AudioBeep(context.Context, *VinRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AudioBeep not implemented")
+}
+func (UnimplementedVcuServer) FingerFetch(context.Context, *VinRequest) (*FingerFetchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FingerFetch not implemented")
+}
+func (UnimplementedVcuServer) FingerAdd(context.Context, *VinRequest) (*FingerAddReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FingerAdd not implemented")
+}
+func (UnimplementedVcuServer) FingerDel(context.Context, *FingerDelRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FingerDel not implemented")
+}
+func (UnimplementedVcuServer) FingerRst(context.Context, *VinRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FingerRst not implemented")
+}
+func (UnimplementedVcuServer) RemotePairing(context.Context, *VinRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemotePairing not implemented")
+}
+func (UnimplementedVcuServer) RemoteSeat(context.Context, *VinRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteSeat not implemented")
+}
+func (UnimplementedVcuServer) RemoteAlarm(context.Context, *VinRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteAlarm not implemented")
+}
+func (UnimplementedVcuServer) FotaRestart(context.Context, *VinRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FotaRestart not implemented")
+}
+func (UnimplementedVcuServer) FotaVcu(context.Context, *VinRequest) (*TextReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FotaVcu not implemented")
+}
+func (UnimplementedVcuServer) FotaHmi(context.Context, *VinRequest) (*TextReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FotaHmi not implemented")
+}
+func (UnimplementedVcuServer) NetSendUssd(context.Context, *UssdRequest) (*TextReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetSendUssd not implemented")
+}
+func (UnimplementedVcuServer) NetReadSms(context.Context, *VinRequest) (*TextReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetReadSms not implemented")
+}
+func (UnimplementedVcuServer) HbarTripMeter(context.Context, *TripMeterRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HbarTripMeter not implemented")
+}
+func (UnimplementedVcuServer) HbarDrive(context.Context, *DriveRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HbarDrive not implemented")
+}
+func (UnimplementedVcuServer) HbarTrip(context.Context, *TripRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HbarTrip not implemented")
+}
+func (UnimplementedVcuServer) HbarAvg(context.Context, *AvgRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HbarAvg not implemented")
+}
+func (UnimplementedVcuServer) McuSpeedMax(context.Context, *SpeedMaxRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method McuSpeedMax not implemented")
+}
+func (UnimplementedVcuServer) McuSetDriveMode(context.Context, *SetDriveModeRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method McuSetDriveMode not implemented")
+}
+func (UnimplementedVcuServer) McuTemplates(context.Context, *TemplatesRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method McuTemplates not implemented")
+}
+func (UnimplementedVcuServer) ImuAntiThief(context.Context, *SwitchRequest) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImuAntiThief not implemented")
+}
+func (UnimplementedVcuServer) mustEmbedUnimplementedVcuServer() {}
+
+// UnsafeVcuServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VcuServer will
+// result in compilation errors.
+type UnsafeVcuServer interface {
+	mustEmbedUnimplementedVcuServer()
+}
+
+func RegisterVcuServer(s grpc.ServiceRegistrar, srv VcuServer) {
+	s.RegisterService(&Vcu_ServiceDesc, srv)
+}
+
+func _Vcu_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VcuServer).WatchStatus(m, &vcuWatchStatusServer{stream})
+}
+
+type Vcu_WatchStatusServer interface {
+	Send(*StatusEvent) error
+	grpc.ServerStream
+}
+
+type vcuWatchStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *vcuWatchStatusServer) Send(m *StatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vcu_WatchReports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VcuServer).WatchReports(m, &vcuWatchReportsServer{stream})
+}
+
+type Vcu_WatchReportsServer interface {
+	Send(*Report) error
+	grpc.ServerStream
+}
+
+type vcuWatchReportsServer struct {
+	grpc.ServerStream
+}
+
+func (x *vcuWatchReportsServer) Send(m *Report) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Vcu_GenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).GenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_GenInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).GenInfo(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_GenLed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).GenLed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_GenLed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).GenLed(ctx, req.(*SwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_GenRtc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RtcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).GenRtc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_GenRtc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).GenRtc(ctx, req.(*RtcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_GenBikeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BikeStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).GenBikeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_GenBikeState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).GenBikeState(ctx, req.(*BikeStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_GenLockDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).GenLockDown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_GenLockDown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).GenLockDown(ctx, req.(*SwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_GenCanDebug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).GenCanDebug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_GenCanDebug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).GenCanDebug(ctx, req.(*SwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_ReportFlush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).ReportFlush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_ReportFlush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).ReportFlush(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_ReportBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).ReportBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_ReportBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).ReportBlock(ctx, req.(*SwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_ReportInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntervalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).ReportInterval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_ReportInterval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).ReportInterval(ctx, req.(*IntervalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_ReportFrame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FrameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).ReportFrame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_ReportFrame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).ReportFrame(ctx, req.(*FrameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_AudioBeep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).AudioBeep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_AudioBeep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).AudioBeep(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_FingerFetch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).FingerFetch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_FingerFetch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).FingerFetch(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_FingerAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).FingerAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_FingerAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).FingerAdd(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_FingerDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FingerDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).FingerDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_FingerDel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).FingerDel(ctx, req.(*FingerDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_FingerRst_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).FingerRst(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_FingerRst_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).FingerRst(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_RemotePairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).RemotePairing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_RemotePairing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).RemotePairing(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_RemoteSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).RemoteSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_RemoteSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).RemoteSeat(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_RemoteAlarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).RemoteAlarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_RemoteAlarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).RemoteAlarm(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_FotaRestart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).FotaRestart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_FotaRestart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).FotaRestart(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_FotaVcu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).FotaVcu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_FotaVcu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).FotaVcu(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_FotaHmi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).FotaHmi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_FotaHmi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).FotaHmi(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_NetSendUssd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UssdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).NetSendUssd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_NetSendUssd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).NetSendUssd(ctx, req.(*UssdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_NetReadSms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).NetReadSms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_NetReadSms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).NetReadSms(ctx, req.(*VinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_HbarTripMeter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripMeterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).HbarTripMeter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_HbarTripMeter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).HbarTripMeter(ctx, req.(*TripMeterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_HbarDrive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).HbarDrive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_HbarDrive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).HbarDrive(ctx, req.(*DriveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_HbarTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).HbarTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_HbarTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).HbarTrip(ctx, req.(*TripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_HbarAvg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).HbarAvg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_HbarAvg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).HbarAvg(ctx, req.(*AvgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_McuSpeedMax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpeedMaxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).McuSpeedMax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_McuSpeedMax_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).McuSpeedMax(ctx, req.(*SpeedMaxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_McuSetDriveMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDriveModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).McuSetDriveMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_McuSetDriveMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).McuSetDriveMode(ctx, req.(*SetDriveModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_McuTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).McuTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_McuTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).McuTemplates(ctx, req.(*TemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vcu_ImuAntiThief_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcuServer).ImuAntiThief(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vcu_ImuAntiThief_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcuServer).ImuAntiThief(ctx, req.(*SwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Vcu_ServiceDesc is the grpc.ServiceDesc for Vcu service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Vcu_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vcu.Vcu",
+	HandlerType: (*VcuServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenInfo",
+			Handler:    _Vcu_GenInfo_Handler,
+		},
+		{
+			MethodName: "GenLed",
+			Handler:    _Vcu_GenLed_Handler,
+		},
+		{
+			MethodName: "GenRtc",
+			Handler:    _Vcu_GenRtc_Handler,
+		},
+		{
+			MethodName: "GenBikeState",
+			Handler:    _Vcu_GenBikeState_Handler,
+		},
+		{
+			MethodName: "GenLockDown",
+			Handler:    _Vcu_GenLockDown_Handler,
+		},
+		{
+			MethodName: "GenCanDebug",
+			Handler:    _Vcu_GenCanDebug_Handler,
+		},
+		{
+			MethodName: "ReportFlush",
+			Handler:    _Vcu_ReportFlush_Handler,
+		},
+		{
+			MethodName: "ReportBlock",
+			Handler:    _Vcu_ReportBlock_Handler,
+		},
+		{
+			MethodName: "ReportInterval",
+			Handler:    _Vcu_ReportInterval_Handler,
+		},
+		{
+			MethodName: "ReportFrame",
+			Handler:    _Vcu_ReportFrame_Handler,
+		},
+		{
+			MethodName: "AudioBeep",
+			Handler:    _Vcu_AudioBeep_Handler,
+		},
+		{
+			MethodName: "FingerFetch",
+			Handler:    _Vcu_FingerFetch_Handler,
+		},
+		{
+			MethodName: "FingerAdd",
+			Handler:    _Vcu_FingerAdd_Handler,
+		},
+		{
+			MethodName: "FingerDel",
+			Handler:    _Vcu_FingerDel_Handler,
+		},
+		{
+			MethodName: "FingerRst",
+			Handler:    _Vcu_FingerRst_Handler,
+		},
+		{
+			MethodName: "RemotePairing",
+			Handler:    _Vcu_RemotePairing_Handler,
+		},
+		{
+			MethodName: "RemoteSeat",
+			Handler:    _Vcu_RemoteSeat_Handler,
+		},
+		{
+			MethodName: "RemoteAlarm",
+			Handler:    _Vcu_RemoteAlarm_Handler,
+		},
+		{
+			MethodName: "FotaRestart",
+			Handler:    _Vcu_FotaRestart_Handler,
+		},
+		{
+			MethodName: "FotaVcu",
+			Handler:    _Vcu_FotaVcu_Handler,
+		},
+		{
+			MethodName: "FotaHmi",
+			Handler:    _Vcu_FotaHmi_Handler,
+		},
+		{
+			MethodName: "NetSendUssd",
+			Handler:    _Vcu_NetSendUssd_Handler,
+		},
+		{
+			MethodName: "NetReadSms",
+			Handler:    _Vcu_NetReadSms_Handler,
+		},
+		{
+			MethodName: "HbarTripMeter",
+			Handler:    _Vcu_HbarTripMeter_Handler,
+		},
+		{
+			MethodName: "HbarDrive",
+			Handler:    _Vcu_HbarDrive_Handler,
+		},
+		{
+			MethodName: "HbarTrip",
+			Handler:    _Vcu_HbarTrip_Handler,
+		},
+		{
+			MethodName: "HbarAvg",
+			Handler:    _Vcu_HbarAvg_Handler,
+		},
+		{
+			MethodName: "McuSpeedMax",
+			Handler:    _Vcu_McuSpeedMax_Handler,
+		},
+		{
+			MethodName: "McuSetDriveMode",
+			Handler:    _Vcu_McuSetDriveMode_Handler,
+		},
+		{
+			MethodName: "McuTemplates",
+			Handler:    _Vcu_McuTemplates_Handler,
+		},
+		{
+			MethodName: "ImuAntiThief",
+			Handler:    _Vcu_ImuAntiThief_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _Vcu_WatchStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchReports",
+			Handler:       _Vcu_WatchReports_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "vcu.proto",
+}
@@ -1,19 +1,26 @@
 package sdk
 
 import (
+	"context"
 	"errors"
-	"log"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
 )
 
 type Sdk struct {
-	logger  *log.Logger
+	logger  *logger
 	sleeper Sleeper
 	client  *client
 }
 
 // New create new instance of Sdk for VCU (Vehicle Control Unit).
+// Logging enable stderr logs, unless ClientConfig.Logger is set.
 func New(cc ClientConfig, logging bool) Sdk {
-	logger := newLogger(logging, "SDK")
+	l := cc.Logger
+	if l == nil {
+		l = defaultLogger(logging)
+	}
+	logger := newLogger(l, cc.LogPayload)
 	return Sdk{
 		logger:  logger,
 		sleeper: &realSleeper{},
@@ -21,18 +28,47 @@ func New(cc ClientConfig, logging bool) Sdk {
 	}
 }
 
-// Connect open connection to mqtt client
-func (s *Sdk) Connect() error {
-	token := s.client.Connect()
-	if token.Wait() && token.Error() != nil {
-		return token.Error()
+// NewWithClient create new instance of Sdk on top of existing mqtt client.
+// It is mainly used to plug fake client & sleeper on unit test, see package sdktest.
+// If sleeper is nil, the real one is used.
+func NewWithClient(mc mqtt.Client, sleeper Sleeper, logging bool) Sdk {
+	return NewWithClientConfig(mc, sleeper, ClientConfig{}, logging)
+}
+
+// NewWithClientConfig is NewWithClient with SDK options of cc, such as Topics, Dispatch & Logger.
+// Broker options of cc are validated but not used.
+func NewWithClientConfig(mc mqtt.Client, sleeper Sleeper, cc ClientConfig, logging bool) Sdk {
+	if sleeper == nil {
+		sleeper = &realSleeper{}
 	}
-	return nil
+	l := cc.Logger
+	if l == nil {
+		l = defaultLogger(logging)
+	}
+	logger := newLogger(l, cc.LogPayload)
+	return Sdk{
+		logger:  logger,
+		sleeper: sleeper,
+		client:  newFakeClient(mc, &cc, logger),
+	}
+}
+
+// Connect open connection to mqtt client, it return error of invalid ClientConfig.
+// See ConnectContext to retry & cancel.
+func (s *Sdk) Connect() error {
+	return s.ConnectContext(context.Background())
 }
 
 // Disconnect close connection to mqtt client
 func (s *Sdk) Disconnect() {
 	s.client.Disconnect(100)
+	s.client.workers.stop()
+	s.client.setState(ConnStateDisconnected, nil)
+}
+
+// IsConnected check if connection to mqtt client is established.
+func (s *Sdk) IsConnected() bool {
+	return s.client.IsConnected()
 }
 
 // NewCommander create new instance of commander for specific VIN.
@@ -52,55 +88,65 @@ func (s *Sdk) NewCommander(vin int) (*commander, error) {
 //
 // listen by range :
 // s.AddListener(listerner, sdk.VinRange(min, max)...)
-func (s *Sdk) AddListener(ls Listener, vins ...int) error {
+//
+// Several listeners can be added on the same vins, each message is passed to all of them.
+// The returned handle remove only this listener, see ListenerHandle.Remove.
+// With ClientConfig.ShareGroup, messages are shared among clients of the group.
+func (s *Sdk) AddListener(ls Listener, vins ...int) (*ListenerHandle, error) {
 	if ls.StatusFunc == nil && ls.ReportFunc == nil {
-		return errors.New("at least 1 listener supplied")
+		return nil, errors.New("at least 1 listener supplied")
+	}
+	if ls.Policy >= ReportPolicyLimit {
+		return nil, errInputOutOfRange("policy")
 	}
 	if !s.client.IsConnected() {
-		return errClientDisconnected
+		return nil, errClientDisconnected
 	}
 
-	global := len(vins) == 0
+	status, report := s.client.topics.status, s.client.topics.report
+	h := &ListenerHandle{
+		client: s.client,
+		id:     s.client.routes.nextID(),
+	}
 
 	ls.logger = s.logger
+	ls.client = s.client
 	if ls.StatusFunc != nil {
-		switch global {
-		case false:
-			topics := setTopicVins(TOPIC_STATUS, vins)
-			if err := s.client.subMulti(topics, QOS_SUB_STATUS, ls.status()); err != nil {
-				return err
-			}
-		case true:
-			if err := s.client.sub(TOPIC_STATUS, QOS_SUB_STATUS, ls.status()); err != nil {
-				return err
-			}
+		topics := s.listenTopics(status, vins)
+		if err := s.client.addRoute(topics, QOS_SUB_STATUS, status, false, h.id, &ls); err != nil {
+			return nil, err
 		}
+		h.topics = append(h.topics, topics...)
 	}
 
 	if ls.ReportFunc != nil {
-		switch global {
-		case false:
-			topics := setTopicVins(TOPIC_REPORT, vins)
-			if err := s.client.subMulti(topics, QOS_SUB_REPORT, ls.report()); err != nil {
-				return err
-			}
-		case true:
-			if err := s.client.sub(TOPIC_REPORT, QOS_SUB_REPORT, ls.report()); err != nil {
-				return err
-			}
+		topics := s.listenTopics(report, vins)
+		if err := s.client.addRoute(topics, QOS_SUB_REPORT, report, true, h.id, &ls); err != nil {
+			_ = h.Remove()
+			return nil, err
 		}
+		h.topics = append(h.topics, topics...)
 	}
-	return nil
+	return h, nil
 }
 
-// RemoveListener unsubscribe status and report topic for spesific vin in range.
-// If vins is empty, it will unsubscribe from all vins.
+// RemoveListener unsubscribe status and report topic for spesific vin in range,
+// all listeners of those topics are removed.
+// If vins is empty, it will unsubscribe the listeners of all vins.
 func (s *Sdk) RemoveListener(vins ...int) error {
 	topics := append(
-		setTopicVins(TOPIC_STATUS, vins),
-		setTopicVins(TOPIC_REPORT, vins)...,
+		s.listenTopics(s.client.topics.status, vins),
+		s.listenTopics(s.client.topics.report, vins)...,
 	)
-	return s.client.unsub(topics)
+	return s.client.dropRoute(topics)
+}
+
+// listenTopics return subscribed topics of vins, or the global filter if empty.
+func (s *Sdk) listenTopics(t topicTemplate, vins []int) []string {
+	if len(vins) == 0 {
+		return []string{s.client.share(t.filter())}
+	}
+	return s.client.shareAll(t.topics(vins))
 }
 
 // VinRange generate array of integer from min to max.
@@ -0,0 +1,868 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/garda-energi/gen.vcu.sdk/broker"
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
+)
+
+const brokerTimeout = 5 * time.Second
+
+func TestSdkBrokerListener(t *testing.T) {
+	b, api := newBrokerApi(t)
+
+	statuses := make(chan bool, 10)
+	reports := make(chan int, 10)
+	_, err := api.AddListener(Listener{
+		StatusFunc: func(vin int, online bool) {
+			statuses <- online
+		},
+		ReportFunc: func(vin int, report *ReportPacket) {
+			reports <- vin
+		},
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	publishReport(t, b, testVin)
+	assertReceived(t, reports, testVin)
+
+	// only matched topics are delivered
+	_ = b.Publish(setTopicVin(TOPIC_REPORT, testVin)+"/x", 1, false, []byte{})
+	_ = b.Publish(setTopicVin(TOPIC_STATUS, testVin), 1, true, []byte("1"))
+	assertReceived(t, statuses, true)
+
+	select {
+	case vin := <-reports:
+		t.Error("want no report, got ", vin)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSdkBrokerMultipleListeners(t *testing.T) {
+	b, api := newBrokerApi(t)
+	topic := setTopicVin(TOPIC_REPORT, testVin)
+
+	first, second := make(chan int, 10), make(chan int, 10)
+	handles := []*ListenerHandle{}
+	for _, ch := range []chan int{first, second} {
+		ch := ch
+		h, err := api.AddListener(Listener{
+			ReportFunc: func(vin int, report *ReportPacket) {
+				ch <- vin
+			},
+		}, testVin)
+		if err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+		handles = append(handles, h)
+	}
+
+	publishReport(t, b, testVin)
+	assertReceived(t, first, testVin)
+	assertReceived(t, second, testVin)
+
+	// topic is kept subscribed for the other listener
+	if err := handles[0].Remove(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if len(b.Subscribers(topic)) != 1 {
+		t.Error("want topic subscribed")
+	}
+	publishReport(t, b, testVin)
+	assertReceived(t, second, testVin)
+	select {
+	case vin := <-first:
+		t.Error("want no report, got ", vin)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	for _, h := range []*ListenerHandle{handles[1], handles[1]} {
+		if err := h.Remove(); err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+	}
+	waitBroker(t, func() bool { return len(b.Subscribers(topic)) == 0 })
+}
+
+// countObserver count observed reports.
+type countObserver struct {
+	nopObserver
+	reports int32
+}
+
+func (o *countObserver) ReportReceived(vin int, version int, err error) {
+	atomic.AddInt32(&o.reports, 1)
+}
+
+func TestSdkBrokerListenersObservedOnce(t *testing.T) {
+	b, api := newBrokerApi(t)
+	obs := &countObserver{}
+	api.Observe(obs)
+
+	reports := make(chan int, 10)
+	for _, policy := range []ReportPolicy{ReportPolicyLenient, ReportPolicyStrict} {
+		_, err := api.AddListener(Listener{
+			ReportFunc: func(vin int, report *ReportPacket) {
+				reports <- vin
+			},
+			Policy: policy,
+		}, testVin)
+		if err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+	}
+
+	publishReport(t, b, testVin)
+	assertReceived(t, reports, testVin)
+	assertReceived(t, reports, testVin)
+	if got := atomic.LoadInt32(&obs.reports); got != 1 {
+		t.Errorf("want %d, got %d", 1, got)
+	}
+}
+
+func TestSdkBrokerRemoveGlobalListener(t *testing.T) {
+	b, api := newBrokerApi(t)
+
+	_, err := api.AddListener(Listener{
+		StatusFunc: func(vin int, online bool) {},
+		ReportFunc: func(vin int, report *ReportPacket) {},
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if err := api.RemoveListener(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	for _, topic := range []string{TOPIC_STATUS, TOPIC_REPORT} {
+		topic := setTopicVin(topic, testVin)
+		waitBroker(t, func() bool { return len(b.Subscribers(topic)) == 0 })
+	}
+}
+
+func TestSdkBrokerResubscribe(t *testing.T) {
+	b, api := newBrokerApi(t)
+
+	reports := make(chan int, 10)
+	_, err := api.AddListener(Listener{
+		ReportFunc: func(vin int, report *ReportPacket) {
+			reports <- vin
+		},
+	}, testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	// report published while reconnecting is lost,
+	// keep publishing until listener is resubscribed.
+	b.Disconnect()
+	waitBroker(t, func() bool {
+		publishReport(t, b, testVin)
+		select {
+		case <-reports:
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	})
+}
+
+func TestSdkBrokerResubscribeMissing(t *testing.T) {
+	var reject int32
+	rejected := setTopicVin(TOPIC_REPORT, testVin+1)
+
+	b := broker.New()
+	b.Authorize = func(clientID, filter string) bool {
+		return atomic.LoadInt32(&reject) == 0 || filter != rejected
+	}
+	if err := b.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { b.Close() })
+
+	api := New(ClientConfig{Host: b.Host(), Port: b.Port()}, false)
+	api.client.resubWait = 20 * time.Millisecond
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer api.Disconnect()
+
+	// more topics than a batch
+	vins := VinRange(testVin, testVin+2*RESUBSCRIBE_BATCH_MAX)
+	reports := make(chan int, 10)
+	_, err := api.AddListener(Listener{
+		ReportFunc: func(vin int, report *ReportPacket) {
+			reports <- vin
+		},
+	}, vins...)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	atomic.StoreInt32(&reject, 1)
+	b.Disconnect()
+	waitBroker(t, func() bool {
+		missing := api.Health().Missing
+		return len(missing) == 1 && missing[0] == rejected
+	})
+	if err := api.Health().LastError; !errors.Is(err, errSubscribeFailed(rejected)) {
+		t.Errorf("want %s, got %v", errSubscribeFailed(rejected), err)
+	}
+	for _, vin := range []int{testVin, vins[len(vins)-1]} {
+		if len(b.Subscribers(setTopicVin(TOPIC_REPORT, vin))) != 1 {
+			t.Errorf("want %d resubscribed", vin)
+		}
+	}
+
+	// missing topic is retried until accepted
+	atomic.StoreInt32(&reject, 0)
+	waitBroker(t, func() bool { return len(api.Health().Missing) == 0 })
+	publishReport(t, b, testVin+1)
+	assertReceived(t, reports, testVin+1)
+}
+
+func TestSdkBrokerPersistentSession(t *testing.T) {
+	b := broker.New()
+	if err := b.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { b.Close() })
+
+	cc := ClientConfig{Host: b.Host(), Port: b.Port(), ClientID: "consumer", PersistentSession: true}
+	listener := func(reports chan int) Listener {
+		return Listener{
+			ReportFunc: func(vin int, report *ReportPacket) {
+				reports <- vin
+			},
+		}
+	}
+
+	api := New(cc, false)
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if _, err := api.AddListener(listener(make(chan int, 10)), testVin); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	api.Disconnect()
+	waitBroker(t, func() bool { return len(b.Clients()) == 0 })
+
+	// report published while consumer is restarting is kept by broker
+	publishReport(t, b, testVin)
+
+	api = New(cc, false)
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer api.Disconnect()
+
+	reports := make(chan int, 10)
+	if _, err := api.AddListener(listener(reports), testVin); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	assertReceived(t, reports, testVin)
+}
+
+func TestSdkBrokerConnectionState(t *testing.T) {
+	b := broker.New()
+	if err := b.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { b.Close() })
+
+	var mu sync.Mutex
+	var states []ConnState
+	api := New(ClientConfig{Host: b.Host(), Port: b.Port()}, false)
+	api.OnConnectionChange(func(state ConnState, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		states = append(states, state)
+	})
+	if got := api.State(); got != ConnStateDisconnected {
+		t.Errorf("want %s, got %s", ConnStateDisconnected, got)
+	}
+
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	reports := make(chan int, 10)
+	_, err := api.AddListener(Listener{
+		ReportFunc: func(vin int, report *ReportPacket) {
+			reports <- vin
+		},
+	}, testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	// wait report received, so reconnect is completed before disconnect
+	b.Disconnect()
+	waitBroker(t, func() bool {
+		publishReport(t, b, testVin)
+		select {
+		case <-reports:
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	})
+	if got := api.Health().Reconnects; got != 1 {
+		t.Errorf("want 1 reconnect, got %d", got)
+	}
+	api.Disconnect()
+
+	health := api.Health()
+	if health.State != ConnStateDisconnected {
+		t.Errorf("want %s, got %s", ConnStateDisconnected, health.State)
+	}
+	if health.LastError == nil {
+		t.Error("want last error of lost connection, got nil")
+	}
+
+	want := []ConnState{
+		ConnStateConnecting,
+		ConnStateConnected,
+		ConnStateReconnecting,
+		ConnStateConnected,
+		ConnStateDisconnected,
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if fmt.Sprint(states) != fmt.Sprint(want) {
+		t.Errorf("want %v, got %v", want, states)
+	}
+}
+
+func TestSdkBrokerConnectRetry(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	addr := ln.Addr().(*net.TCPAddr)
+	ln.Close()
+
+	cc := ClientConfig{
+		Host:  addr.IP.String(),
+		Port:  addr.Port,
+		Retry: RetryPolicy{Attempts: -1, Interval: 20 * time.Millisecond},
+	}
+
+	t.Run("until context done", func(t *testing.T) {
+		api := New(cc, false)
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		if err := api.ConnectContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("want %s, got %v", context.DeadlineExceeded, err)
+		}
+		if health := api.Health(); health.State != ConnStateDisconnected || health.LastError == nil {
+			t.Errorf("want disconnected with last error, got %+v", health)
+		}
+	})
+
+	t.Run("limited attempts", func(t *testing.T) {
+		cc := cc
+		cc.Retry.Attempts = 2
+		api := New(cc, false)
+
+		err := api.ConnectContext(context.Background())
+		if err == nil || errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("want connect error, got %v", err)
+		}
+	})
+
+	t.Run("broker started later", func(t *testing.T) {
+		api := New(cc, false)
+		b := broker.New()
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			if err := b.Start(addr.String()); err != nil {
+				t.Error("want no error, got ", err)
+			}
+		}()
+		t.Cleanup(func() { b.Close() })
+
+		ctx, cancel := context.WithTimeout(context.Background(), brokerTimeout)
+		defer cancel()
+		if err := api.ConnectContext(ctx); err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+		defer api.Disconnect()
+
+		if got := api.State(); got != ConnStateConnected {
+			t.Errorf("want %s, got %s", ConnStateConnected, got)
+		}
+	})
+}
+
+func TestSdkBrokerWebsocket(t *testing.T) {
+	b := broker.New()
+	t.Cleanup(func() { b.Close() })
+
+	mux := http.NewServeMux()
+	ws := b.WebsocketHandler()
+	mux.HandleFunc("/mqtt", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		ws.ServeHTTP(w, r)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	addr := srv.Listener.Addr().(*net.TCPAddr)
+
+	proxy, connects := newConnectProxy(t)
+	auth := http.Header{"Authorization": []string{"Bearer token"}}
+
+	testCases := []struct {
+		desc    string
+		path    string
+		headers http.Header
+		proxy   string
+		success bool
+	}{
+		{desc: "with path & header", path: "/mqtt", headers: auth, success: true},
+		{desc: "through proxy", path: "/mqtt", headers: auth, proxy: proxy, success: true},
+		{desc: "wrong path", path: "/ws", headers: auth},
+		{desc: "without header", path: "/mqtt"},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			api := New(ClientConfig{
+				Host:     addr.IP.String(),
+				Port:     addr.Port,
+				Protocol: "ws",
+				Path:     tC.path,
+				Headers:  tC.headers,
+				Proxy:    tC.proxy,
+			}, false)
+			err := api.Connect()
+			if !tC.success {
+				if err == nil {
+					api.Disconnect()
+					t.Fatal("want error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			defer api.Disconnect()
+
+			reports := make(chan int, 10)
+			_, err = api.AddListener(Listener{
+				ReportFunc: func(vin int, report *ReportPacket) {
+					reports <- vin
+				},
+			}, testVin)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			publishReport(t, b, testVin)
+			assertReceived(t, reports, testVin)
+		})
+	}
+
+	if got := atomic.LoadInt32(connects); got != 1 {
+		t.Errorf("want 1 proxy connect, got %d", got)
+	}
+}
+
+func TestSdkBrokerCommander(t *testing.T) {
+	b, api := newBrokerApi(t)
+
+	cancel, err := b.Subscribe(TOPIC_COMMAND, func(msg broker.Message) {
+		if len(msg.Payload) == 0 {
+			return
+		}
+		cmd, err := DecodeCommand(msg.Payload)
+		if err != nil {
+			t.Error("want no error, got ", err)
+			return
+		}
+		res, err := cmd.EncodeResponse(ResCodeOk, []byte("VCU v.1"))
+		if err != nil {
+			t.Error("want no error, got ", err)
+			return
+		}
+
+		topic := setTopicVin(TOPIC_RESPONSE, int(cmd.Header.Vin))
+		go func() {
+			// device needs time to execute the command after ack
+			_ = b.Publish(topic, 1, false, EncodeAck())
+			time.Sleep(100 * time.Millisecond)
+			_ = b.Publish(topic, 1, false, res)
+		}()
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cancel()
+
+	cmder, err := api.NewCommander(testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cmder.Destroy()
+
+	got, err := cmder.GenInfo()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if want := "VCU v.1"; got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestSdkBrokerShareGroup(t *testing.T) {
+	b, _ := newBrokerApi(t)
+
+	reports := make(chan int, 10)
+	counts := make([]int32, 2)
+	for i := range counts {
+		i := i
+		api := connectBroker(t, b, ClientConfig{MQTT5: true, ShareGroup: "consumer"})
+		_, err := api.AddListener(Listener{
+			ReportFunc: func(vin int, report *ReportPacket) {
+				atomic.AddInt32(&counts[i], 1)
+				reports <- vin
+			},
+		})
+		if err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+	}
+
+	for i := 0; i < 4; i++ {
+		publishReport(t, b, testVin)
+		assertReceived(t, reports, testVin)
+	}
+	select {
+	case vin := <-reports:
+		t.Error("want each report received once, got extra ", vin)
+	case <-time.After(100 * time.Millisecond):
+	}
+	for i := range counts {
+		if got := atomic.LoadInt32(&counts[i]); got != 2 {
+			t.Errorf("want 2 reports on consumer %d, got %d", i, got)
+		}
+	}
+}
+
+func TestSdkBrokerCommanderMQTT5(t *testing.T) {
+	b, _ := newBrokerApi(t)
+	api := connectBroker(t, b, ClientConfig{MQTT5: true})
+
+	cancel, err := b.Subscribe(TOPIC_COMMAND, func(msg broker.Message) {
+		if len(msg.Payload) == 0 {
+			return
+		}
+		cmd, err := DecodeCommand(msg.Payload)
+		if err != nil {
+			t.Error("want no error, got ", err)
+			return
+		}
+		props := msg.Props
+		if want := setTopicVin(TOPIC_RESPONSE, testVin); props.ResponseTopic != want {
+			t.Errorf("want %s, got %s", want, props.ResponseTopic)
+		}
+		if props.MessageExpiry == nil || *props.MessageExpiry == 0 {
+			t.Error("want command expiry")
+		}
+		if len(props.CorrelationData) != CORRELATION_DATA_LEN {
+			t.Errorf("want %d bytes correlation, got %v", CORRELATION_DATA_LEN, props.CorrelationData)
+		}
+
+		stale, _ := cmd.EncodeResponse(ResCodeOk, []byte("stale"))
+		res, _ := cmd.EncodeResponse(ResCodeOk, []byte("VCU v.1"))
+		reply := func(payload []byte, correlation []byte) {
+			_ = b.PublishMessage(broker.Message{
+				Topic:   props.ResponseTopic,
+				Qos:     1,
+				Payload: payload,
+				Props:   mqtt5.Properties{CorrelationData: correlation},
+			})
+		}
+		go func() {
+			// ack sent before commander wait for it is flushed
+			time.Sleep(100 * time.Millisecond)
+			reply(EncodeAck(), props.CorrelationData)
+			time.Sleep(100 * time.Millisecond)
+			// response of other command is ignored
+			reply(stale, []byte("other"))
+			reply(res, props.CorrelationData)
+		}()
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cancel()
+
+	cmder, err := api.NewCommander(testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cmder.Destroy()
+
+	got, err := cmder.GenInfo()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if want := "VCU v.1"; got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestSdkBrokerTopicScheme(t *testing.T) {
+	b, _ := newBrokerApi(t)
+	api := connectBroker(t, b, ClientConfig{Topics: PrefixTopics("stg")})
+
+	reports := make(chan int, 10)
+	_, err := api.AddListener(Listener{
+		ReportFunc: func(vin int, report *ReportPacket) {
+			reports <- vin
+		},
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	// report of other fleet is not delivered
+	publishReport(t, b, testVin)
+	select {
+	case vin := <-reports:
+		t.Error("want no report, got ", vin)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	packet, err := NewReportBuilder(1, testVin).Encode()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	topic := "stg/VCU/" + fmt.Sprint(testVin) + "/RPT"
+
+	// listener is resubscribed with the same scheme
+	b.Disconnect()
+	waitBroker(t, func() bool {
+		_ = b.Publish(topic, 1, false, packet)
+		select {
+		case vin := <-reports:
+			return vin == testVin
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	})
+
+	cancel, err := b.Subscribe("stg/VCU/+/CMD", func(msg broker.Message) {
+		if len(msg.Payload) == 0 {
+			return
+		}
+		cmd, err := DecodeCommand(msg.Payload)
+		if err != nil {
+			t.Error("want no error, got ", err)
+			return
+		}
+		res, err := cmd.EncodeResponse(ResCodeOk, []byte("VCU v.1"))
+		if err != nil {
+			t.Error("want no error, got ", err)
+			return
+		}
+
+		topic := "stg/VCU/" + fmt.Sprint(cmd.Header.Vin) + "/RSP"
+		go func() {
+			_ = b.Publish(topic, 1, false, EncodeAck())
+			time.Sleep(100 * time.Millisecond)
+			_ = b.Publish(topic, 1, false, res)
+		}()
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cancel()
+
+	cmder, err := api.NewCommander(testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cmder.Destroy()
+
+	got, err := cmder.GenInfo()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if want := "VCU v.1"; got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestSdkBrokerDispatch(t *testing.T) {
+	b, _ := newBrokerApi(t)
+	api := connectBroker(t, b, ClientConfig{Dispatch: DispatchConfig{Workers: 2}})
+
+	release := make(chan struct{})
+	defer close(release)
+	reports := make(chan int, 10)
+	_, err := api.AddListener(Listener{
+		ReportFunc: func(vin int, report *ReportPacket) {
+			reports <- vin
+			<-release
+		},
+	}, testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	publishReport(t, b, testVin)
+	assertReceived(t, reports, testVin)
+	publishReport(t, b, testVin)
+	waitBroker(t, func() bool { return api.DispatchStats().Queued == 1 })
+
+	// slow listener doesn't block command response
+	cancel, err := b.Subscribe(TOPIC_COMMAND, func(msg broker.Message) {
+		if len(msg.Payload) == 0 {
+			return
+		}
+		cmd, err := DecodeCommand(msg.Payload)
+		if err != nil {
+			t.Error("want no error, got ", err)
+			return
+		}
+		res, err := cmd.EncodeResponse(ResCodeOk, []byte("VCU v.1"))
+		if err != nil {
+			t.Error("want no error, got ", err)
+			return
+		}
+
+		topic := setTopicVin(TOPIC_RESPONSE, int(cmd.Header.Vin))
+		go func() {
+			_ = b.Publish(topic, 1, false, EncodeAck())
+			time.Sleep(100 * time.Millisecond)
+			_ = b.Publish(topic, 1, false, res)
+		}()
+	})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cancel()
+
+	cmder, err := api.NewCommander(testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cmder.Destroy()
+
+	if _, err := cmder.GenInfo(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+}
+
+func newBrokerApi(t *testing.T) (*broker.Broker, *Sdk) {
+	t.Helper()
+
+	b := broker.New()
+	if err := b.Start("127.0.0.1:0"); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(func() { b.Close() })
+
+	return b, connectBroker(t, b, ClientConfig{})
+}
+
+// connectBroker connect api to broker, host & port of cc are set to the broker.
+func connectBroker(t *testing.T, b *broker.Broker, cc ClientConfig) *Sdk {
+	t.Helper()
+
+	cc.Host, cc.Port = b.Host(), b.Port()
+	api := New(cc, false)
+	api.sleeper = &stubSleeper{after: brokerTimeout}
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(api.Disconnect)
+	return &api
+}
+
+func publishReport(t *testing.T, b *broker.Broker, vin int) {
+	t.Helper()
+
+	packet, err := NewReportBuilder(1, vin).Encode()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if err := b.Publish(setTopicVin(TOPIC_REPORT, vin), 1, false, packet); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+}
+
+// newConnectProxy start HTTP CONNECT proxy, it return proxy url & connect counter.
+func newConnectProxy(t *testing.T) (string, *int32) {
+	t.Helper()
+
+	var connects int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		dst, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			dst.Close()
+			return
+		}
+		atomic.AddInt32(&connects, 1)
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+
+		go func() {
+			io.Copy(dst, conn)
+			dst.Close()
+		}()
+		io.Copy(conn, dst)
+		conn.Close()
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL, &connects
+}
+
+func assertReceived[T comparable](t *testing.T, ch <-chan T, want T) {
+	t.Helper()
+
+	select {
+	case got := <-ch:
+		if got != want {
+			t.Errorf("want %v, got %v", want, got)
+		}
+	case <-time.After(brokerTimeout):
+		t.Fatalf("want %v received", want)
+	}
+}
+
+func waitBroker(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(brokerTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("want condition met before timeout")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
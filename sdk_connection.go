@@ -0,0 +1,148 @@
+package sdk
+
+import (
+	"context"
+	"time"
+)
+
+// ConnectionFunc is called on connection state change, err is cause of lost or failed connection.
+// It is called on mqtt handler goroutine, so it should return quickly.
+type ConnectionFunc func(state ConnState, err error)
+
+// ConnectionHealth is snapshot of connection health.
+type ConnectionHealth struct {
+	State ConnState
+	// Since is when State is entered.
+	Since time.Time
+	// Reconnects is count of connection re-established after lost.
+	Reconnects int
+	// LastError is the last connection error, kept after recovered.
+	LastError error
+	// Missing is topics failed to resubscribe after reconnect, they are retried in background.
+	Missing []string
+}
+
+// RetryPolicy retry initial connect, auto reconnect take over once connected.
+type RetryPolicy struct {
+	// Attempts is maximum connect attempts, zero is once, negative is until context is done.
+	Attempts int
+	// Interval is wait before first retry, default DEFAULT_RETRY_INTERVAL.
+	// It is doubled on each retry, up to MaxInterval (default DEFAULT_MAX_RECONNECT_INTERVAL).
+	Interval    time.Duration
+	MaxInterval time.Duration
+}
+
+// OnConnectionChange set callback of connection state change, pass nil to remove.
+func (s *Sdk) OnConnectionChange(fn ConnectionFunc) {
+	s.client.connMu.Lock()
+	defer s.client.connMu.Unlock()
+
+	s.client.onChange = fn
+}
+
+// State return current connection state.
+func (s *Sdk) State() ConnState {
+	return s.Health().State
+}
+
+// Health return connection state, reconnect counter & last error.
+func (s *Sdk) Health() ConnectionHealth {
+	s.client.connMu.Lock()
+	defer s.client.connMu.Unlock()
+
+	health := s.client.health
+	health.Missing = append([]string(nil), health.Missing...)
+	return health
+}
+
+// ConnectContext open connection to mqtt client, retried by ClientConfig.Retry.
+// It stop when ctx is done, and return the last error.
+func (s *Sdk) ConnectContext(ctx context.Context) error {
+	c := s.client
+	if c.err != nil {
+		c.setState(ConnStateDisconnected, c.err)
+		return c.err
+	}
+
+	policy := c.retry
+	if policy.Interval <= 0 {
+		policy.Interval = DEFAULT_RETRY_INTERVAL
+	}
+	if policy.MaxInterval <= 0 {
+		policy.MaxInterval = DEFAULT_MAX_RECONNECT_INTERVAL
+	}
+
+	c.workers.start()
+	c.setState(ConnStateConnecting, nil)
+	wait := policy.Interval
+	for attempt := 1; ; attempt++ {
+		err := c.connect(ctx)
+		if err == nil {
+			c.setState(ConnStateConnected, nil)
+			return nil
+		}
+		if ctx.Err() != nil || (policy.Attempts >= 0 && attempt >= policy.Attempts) {
+			c.setState(ConnStateDisconnected, err)
+			return err
+		}
+		c.logger.warn(CLI, "Connect failed", "attempt", attempt, "retry", wait, "err", err)
+		c.setLastError(err)
+
+		select {
+		case <-ctx.Done():
+			c.setState(ConnStateDisconnected, ctx.Err())
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		if wait *= 2; wait > policy.MaxInterval {
+			wait = policy.MaxInterval
+		}
+	}
+}
+
+// connect do single connect attempt, it is aborted when ctx is done.
+func (c *client) connect(ctx context.Context) error {
+	token := c.Connect()
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		// close connection established after abandoned
+		go func() {
+			if token.Wait() && token.Error() == nil {
+				c.Disconnect(0)
+			}
+		}()
+		return ctx.Err()
+	}
+}
+
+// setState update connection state and notify callback when changed.
+func (c *client) setState(state ConnState, err error) {
+	c.connMu.Lock()
+	if err != nil {
+		c.health.LastError = err
+	}
+	if c.health.State == state {
+		c.connMu.Unlock()
+		return
+	}
+	if state == ConnStateConnected && c.health.State == ConnStateReconnecting {
+		c.health.Reconnects++
+	}
+	c.health.State = state
+	c.health.Since = time.Now()
+	fn := c.onChange
+	c.connMu.Unlock()
+
+	if fn != nil {
+		fn(state, err)
+	}
+}
+
+func (c *client) setLastError(err error) {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	c.health.LastError = err
+}
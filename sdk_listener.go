@@ -1,44 +1,98 @@
 package sdk
 
-import (
-	"log"
-
-	mqtt "github.com/eclipse/paho.mqtt.golang"
-)
+import mqtt "github.com/eclipse/paho.mqtt.golang"
 
 type statusListener func(vin int, online bool)
 type reportListener func(vin int, report *ReportPacket)
 
-// Listener store status & report callback function
+// Listener store status & report callback function.
+// Policy decide how strict incomming report is validated, default is lenient.
 type Listener struct {
 	StatusFunc statusListener
 	ReportFunc reportListener
-	logger     *log.Logger
+	Policy     ReportPolicy
+	logger     *logger
+	client     *client
+}
+
+// ListenerHandle is Listener added by Sdk.AddListener.
+type ListenerHandle struct {
+	client *client
+	id     uint64
+	topics []string
+}
+
+// Remove remove the listener, topics are unsubscribed when no other listener remain.
+// It is safe to call more than once.
+func (h *ListenerHandle) Remove() error {
+	return h.client.removeRoute(h.topics, h.id)
 }
 
 // status is executed when received new packet on status topic.
-func (ls *Listener) status() mqtt.MessageHandler {
+func (ls *Listener) status(topic topicTemplate) mqtt.MessageHandler {
 	return func(client mqtt.Client, msg mqtt.Message) {
-		ls.logger.Println(RPT, debugPacket(msg))
-		vin := getTopicVin(msg.Topic())
-		packet := packet(msg.Payload())
-
-		ls.StatusFunc(vin, packet.online())
+		ls.logger.packet(RPT, msg)
+		vin, _ := topic.vin(msg.Topic())
+		ls.client.run(vin, func() {
+			handleStatus(vin, msg, []*Listener{ls})
+		})
 	}
 }
 
 // report is executed when received new packet on report topic.
-func (ls *Listener) report() mqtt.MessageHandler {
+func (ls *Listener) report(topic topicTemplate) mqtt.MessageHandler {
 	return func(client mqtt.Client, msg mqtt.Message) {
-		ls.logger.Println(RPT, debugPacket(msg))
+		ls.logger.packet(RPT, msg)
+		vin, _ := topic.vin(msg.Topic())
+		ls.client.run(vin, func() {
+			handleReport(ls.client, ls.logger, vin, msg, []*Listener{ls})
+		})
+	}
+}
+
+// handleStatus pass status to every listener having StatusFunc.
+func handleStatus(vin int, msg mqtt.Message, lss []*Listener) {
+	online := packet(msg.Payload()).online()
+	for _, ls := range lss {
+		if ls.StatusFunc != nil {
+			ls.StatusFunc(vin, online)
+		}
+	}
+}
 
-		vin := getTopicVin(msg.Topic())
+// handleReport decode report once, then validate it by Policy of each listener and pass it.
+// Observer see the report once, failed only when no listener accept it.
+func handleReport(c *client, l *logger, vin int, msg mqtt.Message, lss []*Listener) {
+	version := packetVersion(msg.Payload())
+
+	mapResult, err := decodeReport(msg.Payload())
+	if err != nil {
+		l.warn(RPT, "Cant decode report", "vin", vin, "err", err)
+		c.observe().ReportReceived(vin, version, err)
+		return
+	}
 
-		mapResult, err := decodeReport(msg.Payload())
-		if err != nil {
-			ls.logger.Println("cant decode", err)
-		} else {
-			ls.ReportFunc(vin, mapResult)
+	var accepted []*Listener
+	var rejected error
+	for _, ls := range lss {
+		if ls.ReportFunc == nil {
+			continue
 		}
+		if err := mapResult.validateReport(vin, ls.Policy); err != nil {
+			l.warn(RPT, "Invalid report", "vin", vin, "policy", ls.Policy, "err", err)
+			if rejected == nil {
+				rejected = err
+			}
+			continue
+		}
+		accepted = append(accepted, ls)
+	}
+	if len(accepted) > 0 {
+		rejected = nil
+	}
+	c.observe().ReportReceived(vin, version, rejected)
+
+	for _, ls := range accepted {
+		ls.ReportFunc(vin, mapResult)
 	}
 }
@@ -31,7 +31,7 @@ func TestSdkReportListener(t *testing.T) {
 	api.Connect()
 	defer api.Disconnect()
 
-	if err := api.AddListener(listener, vins...); err != nil {
+	if _, err := api.AddListener(listener, vins...); err != nil {
 		t.Error("want no error, got ", err)
 	}
 	defer api.RemoveListener(vins...)
@@ -364,7 +364,7 @@ func TestSdkStatusListener(t *testing.T) {
 	api.Connect()
 	defer api.Disconnect()
 
-	if err := api.AddListener(listener, vins...); err != nil {
+	if _, err := api.AddListener(listener, vins...); err != nil {
 		t.Error("want no error, got ", err)
 	}
 	defer api.RemoveListener(vins...)
@@ -0,0 +1,176 @@
+package sdk
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// Logger receive SDK logs, keyvals are alternating keys & values,
+// ex: "invoker", "client", "topic", "VCU/354313/RPT".
+// See NewStdLogger, NewSlogLogger & NewZapLogger.
+type Logger interface {
+	Log(level LogLevel, msg string, keyvals ...interface{})
+}
+
+// NewStdLogger create Logger writing lines to w, logs below level are dropped, ex:
+//
+//	SDK 10:04:05 INFO Connected invoker=client
+func NewStdLogger(w io.Writer, prefix string, level LogLevel) Logger {
+	return &stdLogger{
+		out:   log.New(w, fmt.Sprint(prefix, " "), log.Ltime),
+		level: level,
+	}
+}
+
+type stdLogger struct {
+	out   *log.Logger
+	level LogLevel
+}
+
+func (l *stdLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	if level < l.level || level >= LogOff {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString(level.String())
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for i := 0; i < len(keyvals); i += 2 {
+		var v interface{} = "(MISSING)"
+		if i+1 < len(keyvals) {
+			v = keyvals[i+1]
+		}
+		fmt.Fprintf(&b, " %v=%s", keyvals[i], quoteValue(v))
+	}
+	l.out.Println(b.String())
+}
+
+// quoteValue quote value which has space, so line is still parseable.
+func quoteValue(v interface{}) string {
+	s := fmt.Sprint(v)
+	if s == "" || strings.ContainsAny(s, " =\"") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// Slogger is method set of *slog.Logger used by NewSlogLogger.
+type Slogger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// NewSlogLogger adapt *slog.Logger (log/slog) into Logger.
+func NewSlogLogger(l Slogger) Logger {
+	return &slogLogger{l}
+}
+
+type slogLogger struct {
+	l Slogger
+}
+
+func (s *slogLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	switch level {
+	case LogDebug:
+		s.l.Debug(msg, keyvals...)
+	case LogInfo:
+		s.l.Info(msg, keyvals...)
+	case LogWarn:
+		s.l.Warn(msg, keyvals...)
+	case LogError:
+		s.l.Error(msg, keyvals...)
+	}
+}
+
+// ZapSugar is method set of *zap.SugaredLogger used by NewZapLogger.
+type ZapSugar interface {
+	Debugw(msg string, keysAndValues ...interface{})
+	Infow(msg string, keysAndValues ...interface{})
+	Warnw(msg string, keysAndValues ...interface{})
+	Errorw(msg string, keysAndValues ...interface{})
+}
+
+// NewZapLogger adapt zap sugared logger into Logger, ex: NewZapLogger(zapLogger.Sugar()).
+func NewZapLogger(l ZapSugar) Logger {
+	return &zapLogger{l}
+}
+
+type zapLogger struct {
+	l ZapSugar
+}
+
+func (z *zapLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	switch level {
+	case LogDebug:
+		z.l.Debugw(msg, keyvals...)
+	case LogInfo:
+		z.l.Infow(msg, keyvals...)
+	case LogWarn:
+		z.l.Warnw(msg, keyvals...)
+	case LogError:
+		z.l.Errorw(msg, keyvals...)
+	}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Log(LogLevel, string, ...interface{}) {}
+
+// logger is Logger of SDK internals, payload enable packet dumps.
+type logger struct {
+	Logger
+	payload bool
+}
+
+// newLogger wrap l, nil discard all logs.
+func newLogger(l Logger, payload bool) *logger {
+	if l == nil {
+		l = nopLogger{}
+	}
+	return &logger{Logger: l, payload: payload}
+}
+
+// defaultLogger is stderr logger when logging, otherwise discard all logs.
+func defaultLogger(logging bool) Logger {
+	if !logging {
+		return nil
+	}
+	return NewStdLogger(os.Stderr, "SDK", LogDebug)
+}
+
+func (l *logger) log(level LogLevel, invoker component, msg string, keyvals []interface{}) {
+	l.Log(level, msg, append([]interface{}{"invoker", string(invoker)}, keyvals...)...)
+}
+
+func (l *logger) debug(invoker component, msg string, keyvals ...interface{}) {
+	l.log(LogDebug, invoker, msg, keyvals)
+}
+
+func (l *logger) info(invoker component, msg string, keyvals ...interface{}) {
+	l.log(LogInfo, invoker, msg, keyvals)
+}
+
+func (l *logger) warn(invoker component, msg string, keyvals ...interface{}) {
+	l.log(LogWarn, invoker, msg, keyvals)
+}
+
+func (l *logger) error(invoker component, msg string, keyvals ...interface{}) {
+	l.log(LogError, invoker, msg, keyvals)
+}
+
+// packet dump received message in hex, see ClientConfig.LogPayload.
+func (l *logger) packet(invoker component, msg mqtt.Message) {
+	if !l.payload {
+		return
+	}
+	l.debug(invoker, "Received", "topic", msg.Topic(), "payload", byteToHex(msg.Payload()))
+}
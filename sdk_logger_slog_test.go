@@ -0,0 +1,28 @@
+//go:build go1.21
+
+package sdk
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlogLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	h := slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo})
+	l := newLogger(NewSlogLogger(slog.New(h)), false)
+
+	l.debug(CLI, "Subscribed", "topic", "VCU/1/RPT")
+	l.warn(RPT, "Invalid report", "vin", 354313)
+
+	got := buf.String()
+	if strings.Contains(got, "Subscribed") {
+		t.Errorf("want debug dropped, got %s", got)
+	}
+	want := `level=WARN msg="Invalid report" invoker=report vin=354313`
+	if !strings.Contains(got, want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
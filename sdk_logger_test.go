@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// recordLogger store logs as "LEVEL msg k=v ...".
+type recordLogger struct {
+	logs []string
+}
+
+func (r *recordLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	r.logs = append(r.logs, strings.TrimSpace(fmt.Sprintln(level, msg, keyvals)))
+}
+
+// recordSugar implements ZapSugar.
+type recordSugar struct {
+	recordLogger
+}
+
+func (r *recordSugar) Debugw(msg string, kv ...interface{}) { r.Log(LogDebug, msg, kv...) }
+func (r *recordSugar) Infow(msg string, kv ...interface{})  { r.Log(LogInfo, msg, kv...) }
+func (r *recordSugar) Warnw(msg string, kv ...interface{})  { r.Log(LogWarn, msg, kv...) }
+func (r *recordSugar) Errorw(msg string, kv ...interface{}) { r.Log(LogError, msg, kv...) }
+
+func TestStdLogger(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		level   LogLevel
+		keyvals []interface{}
+		want    string
+	}{
+		{
+			desc:    "key values",
+			level:   LogDebug,
+			keyvals: []interface{}{"vin", 354313, "topic", "VCU/354313/RPT"},
+			want:    "WARN Invalid report vin=354313 topic=VCU/354313/RPT",
+		},
+		{
+			desc:    "quoted value",
+			level:   LogWarn,
+			keyvals: []interface{}{"err", errors.New("packet ack corrupt"), "empty", ""},
+			want:    `WARN Invalid report err="packet ack corrupt" empty=""`,
+		},
+		{
+			desc:    "missing value",
+			level:   LogInfo,
+			keyvals: []interface{}{"vin"},
+			want:    "WARN Invalid report vin=(MISSING)",
+		},
+		{
+			desc:  "below level",
+			level: LogError,
+		},
+		{
+			desc:  "off",
+			level: LogOff,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			l := NewStdLogger(buf, "TEST", tC.level)
+			l.Log(LogWarn, "Invalid report", tC.keyvals...)
+
+			got := buf.String()
+			if tC.want == "" {
+				if got != "" {
+					t.Errorf("want no log, got %s", got)
+				}
+				return
+			}
+			if !strings.HasPrefix(got, "TEST ") || !strings.HasSuffix(got, " "+tC.want+"\n") {
+				t.Errorf("want %s, got %s", tC.want, got)
+			}
+		})
+	}
+}
+
+func TestZapLogger(t *testing.T) {
+	sugar := &recordSugar{}
+	l := newLogger(NewZapLogger(sugar), false)
+
+	l.debug(CLI, "Subscribed", "topic", "VCU/1/RPT")
+	l.info(CLI, "Connected")
+	l.warn(RPT, "Invalid report", "vin", 1)
+	l.error(CLI, "TLS reload failed")
+
+	want := []string{
+		"DEBUG Subscribed [invoker client topic VCU/1/RPT]",
+		"INFO Connected [invoker client]",
+		"WARN Invalid report [invoker report vin 1]",
+		"ERROR TLS reload failed [invoker client]",
+	}
+	if got := sugar.logs; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestLoggerPayload(t *testing.T) {
+	msg := &recordMessage{rec: &Record{Topic: "VCU/1/RPT", Payload: []byte{0xAB, 0x01}}}
+
+	testCases := []struct {
+		desc    string
+		payload bool
+		want    []string
+	}{
+		{
+			desc: "disabled",
+		},
+		{
+			desc:    "enabled",
+			payload: true,
+			want:    []string{"DEBUG Received [invoker report topic VCU/1/RPT payload AB01]"},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			rec := &recordLogger{}
+			newLogger(rec, tC.payload).packet(RPT, msg)
+			if fmt.Sprint(rec.logs) != fmt.Sprint(tC.want) {
+				t.Errorf("want %v, got %v", tC.want, rec.logs)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// Observer receive internal events of the SDK, ex: to export metrics.
+// Methods are called synchronously on mqtt handler (or dispatch worker), so it should return quickly.
+type Observer interface {
+	// ReportReceived is called on each incomming report, err is decode or validation error.
+	// Version is -1 when packet is too short to have one.
+	ReportReceived(vin int, version int, err error)
+	// CommandDone is called when command is finished, err is nil on success.
+	// Elapsed is measured from command sent until response validated,
+	// it does not include the 3s sleep after each command.
+	CommandDone(vin int, invoker string, elapsed time.Duration, err error)
+	// Reconnected is called when connection is re-established after lost.
+	Reconnected()
+}
+
+// Observe set observer of internal events, pass nil to stop.
+func (s *Sdk) Observe(o Observer) {
+	s.client.setObserver(o)
+}
+
+// PacketTimeoutOf return which packet is timeout ("ack" or "response"), if err is packet timeout.
+func PacketTimeoutOf(err error) (string, bool) {
+	var e errPacketTimeout
+	if !errors.As(err, &e) {
+		return "", false
+	}
+	return string(e), true
+}
+
+// nopObserver is used when observer is not set.
+type nopObserver struct{}
+
+func (nopObserver) ReportReceived(vin int, version int, err error)                        {}
+func (nopObserver) CommandDone(vin int, invoker string, elapsed time.Duration, err error) {}
+func (nopObserver) Reconnected()                                                          {}
+
+// observerBox wrap observer, so atomic.Value always store the same type.
+type observerBox struct {
+	Observer
+}
+
+// packetVersion read version from header of raw packet, -1 if too short.
+func packetVersion(b []byte) int {
+	if len(b) < 5 {
+		return -1
+	}
+	return int(binary.LittleEndian.Uint16(b[3:5]))
+}
@@ -0,0 +1,218 @@
+package sdk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// Record is a captured mqtt message.
+type Record struct {
+	Time     time.Time
+	Topic    string
+	Qos      byte
+	Retained bool
+	Payload  []byte
+}
+
+// Recorder write captured messages to compact binary file.
+//
+// Each record is stored as uvarint timestamp delta (microseconds),
+// uvarint topic length, topic, flag byte (qos | retained << 2),
+// uvarint payload length and payload.
+type Recorder struct {
+	mutex  *sync.Mutex
+	w      *bufio.Writer
+	last   time.Time
+	header bool
+}
+
+// NewRecorder create recorder writing to w. Call Flush when done.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{
+		mutex: &sync.Mutex{},
+		w:     bufio.NewWriter(w),
+	}
+}
+
+// Write append record, topic & payload longer than RECORD_PAYLOAD_MAX are rejected
+// as RecordReader can't read them back.
+func (r *Recorder) Write(rec Record) error {
+	if len(rec.Topic) > RECORD_PAYLOAD_MAX {
+		return errInvalidLength(len(rec.Topic))
+	}
+	if len(rec.Payload) > RECORD_PAYLOAD_MAX {
+		return errInvalidLength(len(rec.Payload))
+	}
+	if rec.Qos > 2 {
+		return errInputOutOfRange("qos")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.header {
+		r.w.WriteString(RECORD_MAGIC)
+		r.w.WriteByte(RECORD_VERSION)
+		writeVarint(r.w, rec.Time.UnixMicro())
+		r.last = rec.Time
+		r.header = true
+	}
+
+	delta := rec.Time.Sub(r.last).Microseconds()
+	if delta < 0 {
+		delta = 0
+	}
+	r.last = r.last.Add(time.Duration(delta) * time.Microsecond)
+
+	flag := rec.Qos
+	if rec.Retained {
+		flag |= 1 << 2
+	}
+
+	writeUvarint(r.w, uint64(delta))
+	writeUvarint(r.w, uint64(len(rec.Topic)))
+	r.w.WriteString(rec.Topic)
+	r.w.WriteByte(flag)
+	writeUvarint(r.w, uint64(len(rec.Payload)))
+	_, err := r.w.Write(rec.Payload)
+	return err
+}
+
+// Flush write buffered records to underlying writer.
+func (r *Recorder) Flush() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.w.Flush()
+}
+
+// record capture incomming message.
+func (r *Recorder) record(msg mqtt.Message) error {
+	return r.Write(Record{
+		Time:     time.Now(),
+		Topic:    msg.Topic(),
+		Qos:      msg.Qos(),
+		Retained: msg.Retained(),
+		Payload:  msg.Payload(),
+	})
+}
+
+// RecordReader read records written by Recorder.
+type RecordReader struct {
+	r      *bufio.Reader
+	last   time.Time
+	header bool
+}
+
+// NewRecordReader create reader of recorded file.
+func NewRecordReader(r io.Reader) *RecordReader {
+	return &RecordReader{
+		r: bufio.NewReader(r),
+	}
+}
+
+// Next return next record, or io.EOF when no more record.
+func (rr *RecordReader) Next() (*Record, error) {
+	if !rr.header {
+		if err := rr.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	delta, err := binary.ReadUvarint(rr.r)
+	if err != nil {
+		return nil, err
+	}
+	topic, err := rr.readBytes()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	flag, err := rr.r.ReadByte()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	payload, err := rr.readBytes()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+
+	rr.last = rr.last.Add(time.Duration(delta) * time.Microsecond)
+	return &Record{
+		Time:     rr.last,
+		Topic:    string(topic),
+		Qos:      flag & 0x03,
+		Retained: flag&(1<<2) != 0,
+		Payload:  payload,
+	}, nil
+}
+
+func (rr *RecordReader) readHeader() error {
+	magic := make([]byte, len(RECORD_MAGIC)+1)
+	if _, err := io.ReadFull(rr.r, magic); err != nil {
+		if err == io.EOF {
+			return err
+		}
+		return errInvalidRecord
+	}
+	if !bytes.Equal(magic[:len(RECORD_MAGIC)], []byte(RECORD_MAGIC)) ||
+		magic[len(RECORD_MAGIC)] != RECORD_VERSION {
+		return errInvalidRecord
+	}
+
+	start, err := binary.ReadVarint(rr.r)
+	if err != nil {
+		return errInvalidRecord
+	}
+	rr.last = time.UnixMicro(start)
+	rr.header = true
+	return nil
+}
+
+func (rr *RecordReader) readBytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(rr.r)
+	if err != nil {
+		return nil, err
+	}
+	if n > RECORD_PAYLOAD_MAX {
+		return nil, errInvalidRecord
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rr.r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Record capture every message received by subscribed handlers
+// (status, report, command & response) to recorder.
+// Pass nil to stop recording, previous recorder is flushed.
+func (s *Sdk) Record(r *Recorder) error {
+	if old := s.client.setRecorder(r); old != nil {
+		return old.Flush()
+	}
+	return nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func writeUvarint(w *bufio.Writer, v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	w.Write(b[:n])
+}
+
+func writeVarint(w *bufio.Writer, v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	w.Write(b[:n])
+}
@@ -0,0 +1,284 @@
+package sdk
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRecorder(t *testing.T) {
+	start := time.Date(2021, 10, 19, 9, 30, 0, 0, time.UTC)
+	want := []Record{
+		{Time: start, Topic: "VCU/1/STS", Qos: 1, Retained: true, Payload: []byte("1")},
+		{Time: start.Add(time.Second), Topic: "VCU/1/RPT", Qos: 1, Payload: []byte{0x54, 0x40, 0x01}},
+		{Time: start.Add(1500 * time.Millisecond), Topic: "VCU/1/CMD", Qos: 2, Payload: []byte{}},
+		{Time: start.Add(time.Hour), Topic: "VCU/1/RSP", Qos: 0, Payload: []byte("@S")},
+	}
+
+	var buf bytes.Buffer
+	rec := NewRecorder(&buf)
+	for _, r := range want {
+		if err := rec.Write(r); err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+	}
+	if err := rec.Flush(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	got := readRecords(t, &buf)
+	if len(got) != len(want) {
+		t.Fatalf("want %d records, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if !got[i].Time.Equal(want[i].Time) {
+			t.Errorf("#%d time want %s, got %s", i, want[i].Time, got[i].Time)
+		}
+		got[i].Time = want[i].Time
+		if !reflect.DeepEqual(want[i], got[i]) {
+			t.Errorf("#%d want %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestRecorderInvalid(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		desc string
+		rec  Record
+		want error
+	}{
+		{
+			desc: "payload too long",
+			rec:  Record{Time: now, Topic: "VCU/1/RPT", Payload: make([]byte, RECORD_PAYLOAD_MAX+1)},
+			want: errInvalidLength(RECORD_PAYLOAD_MAX + 1),
+		},
+		{
+			desc: "invalid qos",
+			rec:  Record{Time: now, Topic: "VCU/1/RPT", Qos: 3},
+			want: errInputOutOfRange("qos"),
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			rec := NewRecorder(&buf)
+			if err := rec.Write(tC.rec); err != tC.want {
+				t.Errorf("want %s, got %s", tC.want, err)
+			}
+
+			// rejected record is not written, so file is still readable
+			valid := Record{Time: now, Topic: "VCU/1/STS", Payload: []byte("1")}
+			if err := rec.Write(valid); err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			if err := rec.Flush(); err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			got := readRecords(t, &buf)
+			if len(got) != 1 || got[0].Topic != valid.Topic {
+				t.Errorf("want [%s], got %+v", valid.Topic, got)
+			}
+		})
+	}
+}
+
+func TestRecordReaderInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	rec := NewRecorder(&buf)
+	_ = rec.Write(Record{Time: time.Now(), Topic: "VCU/1/STS", Payload: []byte("1")})
+	_ = rec.Flush()
+	valid := buf.Bytes()
+
+	testCases := []struct {
+		desc string
+		file []byte
+		want error
+	}{
+		{
+			desc: "empty file",
+			file: []byte{},
+			want: io.EOF,
+		},
+		{
+			desc: "invalid magic",
+			file: []byte("VCUREX\x01\x00"),
+			want: errInvalidRecord,
+		},
+		{
+			desc: "unknown version",
+			file: []byte("VCUREC\x09\x00"),
+			want: errInvalidRecord,
+		},
+		{
+			desc: "truncated record",
+			file: valid[:len(valid)-1],
+			want: io.ErrUnexpectedEOF,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			_, err := NewRecordReader(bytes.NewReader(tC.file)).Next()
+			if err != tC.want {
+				t.Errorf("want %s, got %s", tC.want, err)
+			}
+		})
+	}
+}
+
+func TestSdkRecord(t *testing.T) {
+	api := newStubApi()
+	api.Connect()
+	defer api.Disconnect()
+
+	vin := 100
+	done := make(chan struct{}, 2)
+	_, _ = api.AddListener(Listener{
+		StatusFunc: func(vin int, online bool) { done <- struct{}{} },
+		ReportFunc: func(vin int, report *ReportPacket) { done <- struct{}{} },
+	}, vin)
+
+	var buf bytes.Buffer
+	api.Record(NewRecorder(&buf))
+
+	sdkStubClient(api).mockStatus(vin, packet("1"))
+	sdkStubClient(api).mockReports(vin, []*ReportPacket{makeReportPacket(1, vin, FrameFull)})
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("want listener called")
+		}
+	}
+
+	if err := api.Record(nil); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if r := api.client.recorder.Load().(*Recorder); r != nil {
+		t.Fatal("want recorder detached")
+	}
+
+	records := readRecords(t, &buf)
+	topics := map[string]bool{}
+	for _, r := range records {
+		topics[r.Topic] = true
+	}
+	want := map[string]bool{
+		setTopicVin(TOPIC_STATUS, vin): true,
+		setTopicVin(TOPIC_REPORT, vin): true,
+	}
+	if !reflect.DeepEqual(want, topics) {
+		t.Errorf("want %v, got %v", want, topics)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	start := time.Now()
+	report, err := NewReportBuilder(1, 2).Encode()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+
+	var buf bytes.Buffer
+	rec := NewRecorder(&buf)
+	_ = rec.Write(Record{Time: start, Topic: "VCU/1/STS", Payload: []byte("1")})
+	_ = rec.Write(Record{Time: start.Add(2 * time.Second), Topic: "VCU/1/CMD", Payload: []byte{1}})
+	_ = rec.Write(Record{Time: start.Add(4 * time.Second), Topic: "VCU/2/RPT", Payload: report})
+	_ = rec.Write(Record{Time: start.Add(8 * time.Second), Topic: "VCU/2/STS", Payload: []byte("0")})
+	_ = rec.Flush()
+	file := buf.Bytes()
+
+	testCases := []struct {
+		desc       string
+		speed      float64
+		vins       []int
+		wantSleeps []time.Duration
+		wantEvents []string
+	}{
+		{
+			desc:       "original speed",
+			speed:      1,
+			wantSleeps: []time.Duration{2 * time.Second, 2 * time.Second, 4 * time.Second},
+			wantEvents: []string{"1 STS", "2 RPT", "2 STS"},
+		},
+		{
+			desc:       "accelerated",
+			speed:      4,
+			wantSleeps: []time.Duration{500 * time.Millisecond, 500 * time.Millisecond, time.Second},
+			wantEvents: []string{"1 STS", "2 RPT", "2 STS"},
+		},
+		{
+			desc:       "max speed, filtered vin",
+			speed:      REPLAY_SPEED_MAX,
+			vins:       []int{2},
+			wantSleeps: []time.Duration{},
+			wantEvents: []string{"2 RPT", "2 STS"},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			sleeper := &recordSleeper{sleeps: []time.Duration{}}
+			events := []string{}
+			ls := Listener{
+				StatusFunc: func(vin int, online bool) {
+					events = append(events, fmt.Sprint(vin, " STS"))
+				},
+				ReportFunc: func(vin int, report *ReportPacket) {
+					events = append(events, fmt.Sprint(vin, " RPT"))
+				},
+			}
+
+			topics, _ := newTopicScheme(TopicScheme{})
+			err := replay(bytes.NewReader(file), ls, tC.speed, sleeper, topics, tC.vins)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			if !reflect.DeepEqual(tC.wantSleeps, sleeper.sleeps) {
+				t.Errorf("want %v, got %v", tC.wantSleeps, sleeper.sleeps)
+			}
+			if !reflect.DeepEqual(tC.wantEvents, events) {
+				t.Errorf("want %v, got %v", tC.wantEvents, events)
+			}
+		})
+	}
+
+	t.Run("invalid speed", func(t *testing.T) {
+		err := Replay(bytes.NewReader(file), noopListener, -1)
+		if want := errInputOutOfRange("speed"); err != want {
+			t.Errorf("want %s, got %s", want, err)
+		}
+	})
+}
+
+func readRecords(t *testing.T, r io.Reader) []Record {
+	t.Helper()
+
+	records := []Record{}
+	rr := NewRecordReader(r)
+	for {
+		rec, err := rr.Next()
+		if err == io.EOF {
+			return records
+		}
+		if err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+		records = append(records, *rec)
+	}
+}
+
+// recordSleeper collect sleep durations without sleeping
+type recordSleeper struct {
+	sleeps []time.Duration
+}
+
+func (s *recordSleeper) Sleep(d time.Duration) {
+	s.sleeps = append(s.sleeps, d)
+}
+
+func (s *recordSleeper) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// Replay feed recorded file to listener callbacks, as if it is received from broker.
+// Speed 1 keep original interval between records, 2 is twice faster, and so on.
+// Use REPLAY_SPEED_MAX to replay without delay.
+// Only status & report records are replayed, if vins is not empty only those are replayed.
+func Replay(r io.Reader, ls Listener, speed float64, vins ...int) error {
+	return ReplayWithTopics(r, TopicScheme{}, ls, speed, vins...)
+}
+
+// ReplayWithTopics replay records of topic layout ts, see Replay.
+func ReplayWithTopics(r io.Reader, ts TopicScheme, ls Listener, speed float64, vins ...int) error {
+	topics, err := newTopicScheme(ts)
+	if err != nil {
+		return err
+	}
+	return replay(r, ls, speed, &realSleeper{}, topics, vins)
+}
+
+func replay(r io.Reader, ls Listener, speed float64, sleeper Sleeper, topics topicScheme, vins []int) error {
+	if ls.StatusFunc == nil && ls.ReportFunc == nil {
+		return errors.New("at least 1 listener supplied")
+	}
+	if ls.Policy >= ReportPolicyLimit {
+		return errInputOutOfRange("policy")
+	}
+	if speed < 0 {
+		return errInputOutOfRange("speed")
+	}
+
+	if ls.logger == nil {
+		ls.logger = newLogger(nil, false)
+	}
+	type route struct {
+		topic   topicTemplate
+		handler mqtt.MessageHandler
+	}
+	var routes []route
+	if ls.StatusFunc != nil {
+		routes = append(routes, route{topics.status, ls.status(topics.status)})
+	}
+	if ls.ReportFunc != nil {
+		routes = append(routes, route{topics.report, ls.report(topics.report)})
+	}
+
+	filter := map[int]bool{}
+	for _, vin := range vins {
+		filter[vin] = true
+	}
+
+	rr := NewRecordReader(r)
+	var last time.Time
+	for {
+		rec, err := rr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if speed != REPLAY_SPEED_MAX && !last.IsZero() {
+			if d := rec.Time.Sub(last); d > 0 {
+				sleeper.Sleep(time.Duration(float64(d) / speed))
+			}
+		}
+		last = rec.Time
+
+		for _, rt := range routes {
+			vin, ok := rt.topic.vin(rec.Topic)
+			if !ok || (len(filter) > 0 && !filter[vin]) {
+				continue
+			}
+			rt.handler(nil, &recordMessage{rec: rec})
+		}
+	}
+}
+
+// recordMessage implements mqtt.Message for replayed record.
+type recordMessage struct {
+	rec *Record
+}
+
+func (m *recordMessage) Duplicate() bool   { return false }
+func (m *recordMessage) Qos() byte         { return m.rec.Qos }
+func (m *recordMessage) Retained() bool    { return m.rec.Retained }
+func (m *recordMessage) Topic() string     { return m.rec.Topic }
+func (m *recordMessage) MessageID() uint16 { return 0 }
+func (m *recordMessage) Payload() []byte   { return m.rec.Payload }
+func (m *recordMessage) Ack()              {}
@@ -17,7 +17,7 @@ func TestSdkAddListener(t *testing.T) {
 		defer api.Disconnect()
 
 		want := "at least 1 listener supplied"
-		got := api.AddListener(Listener{}, 123)
+		_, got := api.AddListener(Listener{}, 123)
 		if want != got.Error() {
 			t.Errorf("want %s, got %s", want, got)
 		}
@@ -28,7 +28,7 @@ func TestSdkAddListener(t *testing.T) {
 		api.Connect()
 		defer api.Disconnect()
 
-		got := api.AddListener(noopListener)
+		_, got := api.AddListener(noopListener)
 		if got != nil {
 			t.Error("want no error, got ", got)
 		}
@@ -39,7 +39,7 @@ func TestSdkAddListener(t *testing.T) {
 		api.Connect()
 		defer api.Disconnect()
 
-		got := api.AddListener(Listener{
+		_, got := api.AddListener(Listener{
 			StatusFunc: func(vin int, online bool) {},
 		}, 123)
 		if got != nil {
@@ -52,7 +52,7 @@ func TestSdkAddListener(t *testing.T) {
 		api.Connect()
 		defer api.Disconnect()
 
-		got := api.AddListener(noopListener, 123)
+		_, got := api.AddListener(noopListener, 123)
 		if got != nil {
 			t.Error("want no error, got ", got)
 		}
@@ -63,7 +63,7 @@ func TestSdkAddListener(t *testing.T) {
 		api.Connect()
 		defer api.Disconnect()
 
-		got := api.AddListener(noopListener, VinRange(1, 20)...)
+		_, got := api.AddListener(noopListener, VinRange(1, 20)...)
 		if got != nil {
 			t.Error("want no error, got ", got)
 		}
@@ -89,7 +89,7 @@ func TestSdkConnection(t *testing.T) {
 		vin := 100
 
 		want := errClientDisconnected
-		err := api.AddListener(noopListener, vin)
+		_, err := api.AddListener(noopListener, vin)
 		switch err {
 		case nil:
 			defer api.RemoveListener(vin)
@@ -101,7 +101,7 @@ func TestSdkConnection(t *testing.T) {
 		api.Connect()
 		defer api.Disconnect()
 
-		err = api.AddListener(noopListener, vin)
+		_, err = api.AddListener(noopListener, vin)
 		switch err {
 		case nil:
 			defer api.RemoveListener(vin)
@@ -117,12 +117,12 @@ func TestSdkConnection(t *testing.T) {
 
 		vins := VinRange(5, 10)
 
-		_ = api.AddListener(noopListener, vins...)
+		_, _ = api.AddListener(noopListener, vins...)
 		assertSubscribed(t, api, true, vins)
 
 		addVins := []int{13, 15}
 		curVins := append(vins, addVins...)
-		_ = api.AddListener(noopListener, addVins...)
+		_, _ = api.AddListener(noopListener, addVins...)
 		assertSubscribed(t, api, true, curVins)
 
 		delVins := []int{4, 5, 6, 15}
@@ -0,0 +1,132 @@
+package sdktest
+
+import (
+	"testing"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// Expectation describe how fake device reply expected command.
+// By default it is acknowledged and responded with sdk.ResCodeOk once.
+type Expectation struct {
+	vin      int
+	invoker  string
+	times    int
+	ack      bool
+	respond  bool
+	code     sdk.ResCode
+	message  []byte
+	raw      [][]byte
+	consumed int
+}
+
+// RespondOK reply command with ack and OK response without message.
+func (e *Expectation) RespondOK() *Expectation {
+	return e.RespondWith(sdk.ResCodeOk, nil)
+}
+
+// RespondError reply command with ack and ERROR response with message.
+func (e *Expectation) RespondError(msg string) *Expectation {
+	return e.RespondWith(sdk.ResCodeError, []byte(msg))
+}
+
+// RespondWith reply command with ack and response of code & message.
+func (e *Expectation) RespondWith(code sdk.ResCode, msg []byte) *Expectation {
+	e.ack = true
+	e.respond = true
+	e.code = code
+	e.message = msg
+	return e
+}
+
+// RespondRaw reply command with raw packets, e.g. to simulate corrupted packet.
+func (e *Expectation) RespondRaw(packets ...[]byte) *Expectation {
+	e.raw = packets
+	return e
+}
+
+// NoAck never reply command, commander get ack timeout.
+func (e *Expectation) NoAck() *Expectation {
+	e.ack = false
+	e.respond = false
+	return e
+}
+
+// NoResponse only acknowledge command, commander get response timeout.
+func (e *Expectation) NoResponse() *Expectation {
+	e.ack = true
+	e.respond = false
+	return e
+}
+
+// Times set how many times command is expected, default is once.
+func (e *Expectation) Times(n int) *Expectation {
+	e.times = n
+	return e
+}
+
+// replies build packets sent back for cmd.
+func (e *Expectation) replies(cmd *sdk.CommandPacket) [][]byte {
+	e.consumed++
+	if e.raw != nil {
+		return e.raw
+	}
+
+	replies := [][]byte{}
+	if e.ack {
+		replies = append(replies, sdk.EncodeAck())
+	}
+	if e.respond {
+		res, err := cmd.EncodeResponse(e.code, e.message)
+		if err == nil {
+			replies = append(replies, res)
+		}
+	}
+	return replies
+}
+
+// AssertExpectations check all expected commands are sent, and no unexpected one.
+func (f *Fake) AssertExpectations(t testing.TB) bool {
+	t.Helper()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ok := true
+	for _, e := range f.expectations {
+		if e.times > 0 {
+			t.Errorf("want command %s to VIN %d, got %d of %d",
+				e.invoker, e.vin, e.consumed, e.consumed+e.times)
+			ok = false
+		}
+	}
+	for _, cmd := range f.unexpected {
+		t.Errorf("want no command %s to VIN %d, got one", cmd.Invoker, cmd.Vin)
+		ok = false
+	}
+	return ok
+}
+
+// AssertCommandSent check command with invoker is sent to vin.
+func (f *Fake) AssertCommandSent(t testing.TB, vin int, invoker string) bool {
+	t.Helper()
+
+	for _, cmd := range f.Sent(vin) {
+		if cmd.Invoker == invoker {
+			return true
+		}
+	}
+	t.Errorf("want command %s to VIN %d, got none", invoker, vin)
+	return false
+}
+
+// AssertSubscribed check whether any handler is subscribed to topic.
+func (f *Fake) AssertSubscribed(t testing.TB, topic string, subscribed bool) bool {
+	t.Helper()
+
+	if got := f.Subscribed(topic); got != subscribed {
+		t.Errorf("want %s subscribed %v, got %v", topic, subscribed, got)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,304 @@
+// Package sdktest provide fake mqtt client to unit-test code built on top of sdk.Sdk,
+// without any broker. It acts like broker and the VCU devices at once:
+//
+//	fake := sdktest.New()
+//	api := fake.Sdk()
+//	api.Connect()
+//
+//	fake.ExpectCommand(354313, "GenLed").RespondOK()
+//	fake.SetOnline(354313, true)
+//	fake.EmitReport(354313, report)
+//
+//	fake.AssertExpectations(t)
+//
+// Set Fake.Topics before Sdk to test other topic layout. Tests inside package sdk have
+// their own stub client, as they can't import this package without import cycle.
+package sdktest
+
+import (
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"github.com/garda-energi/gen.vcu.sdk/broker"
+)
+
+// DEFAULT_LATENCY is delay between command, ack & response packet.
+const DEFAULT_LATENCY = 5 * time.Millisecond
+
+// DEFAULT_TIMEOUT replace ack & response timeout of commander.
+const DEFAULT_TIMEOUT = 150 * time.Millisecond
+
+// Fake implements mqtt.Client, route published & emitted packets
+// to subscribed handlers, and reply commands as it is expected.
+type Fake struct {
+	// Latency is delay of each ack & response packet.
+	Latency time.Duration
+	// Timeout is waiting time of commander for ack & response packet.
+	Timeout time.Duration
+	// Topics is topic layout of Sdk, default layout when empty.
+	Topics sdk.TopicScheme
+
+	mu           sync.Mutex
+	connected    bool
+	handlers     map[string][]mqtt.MessageHandler
+	expectations []*Expectation
+	sent         []*sdk.CommandPacket
+	unexpected   []*sdk.CommandPacket
+}
+
+// New create new instance of fake client.
+func New() *Fake {
+	return &Fake{
+		Latency:  DEFAULT_LATENCY,
+		Timeout:  DEFAULT_TIMEOUT,
+		handlers: map[string][]mqtt.MessageHandler{},
+	}
+}
+
+// Sdk create Sdk instance using this fake client, with Topics layout.
+func (f *Fake) Sdk() sdk.Sdk {
+	return sdk.NewWithClientConfig(f, &sleeper{fake: f}, sdk.ClientConfig{Topics: f.Topics}, false)
+}
+
+// ExpectCommand register expected command for vin, by its invoker name (e.g. "GenLed").
+// Unexpected command is never acknowledged, so commander get ack timeout.
+func (f *Fake) ExpectCommand(vin int, invoker string) *Expectation {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	e := &Expectation{
+		vin:     vin,
+		invoker: invoker,
+		times:   1,
+		ack:     true,
+		code:    sdk.ResCodeOk,
+	}
+	f.expectations = append(f.expectations, e)
+	return e
+}
+
+// EmitReport publish encoded report of vin to report listener.
+// It returns after listener callback is executed.
+func (f *Fake) EmitReport(vin int, rp *sdk.ReportPacket) error {
+	b, err := sdk.EncodeReport(rp)
+	if err != nil {
+		return err
+	}
+	f.Emit(sdk.TopicOf(f.topics().Report, vin), b)
+	return nil
+}
+
+// SetOnline publish status of vin to status listener.
+// It returns after listener callback is executed.
+func (f *Fake) SetOnline(vin int, online bool) {
+	payload := []byte("0")
+	if online {
+		payload = []byte("1")
+	}
+	f.Emit(sdk.TopicOf(f.topics().Status, vin), payload)
+}
+
+// Emit publish raw payload to handlers subscribed to topic.
+// It returns after all handlers are executed.
+func (f *Fake) Emit(topic string, payload []byte) {
+	msg := &message{topic: topic, payload: payload}
+	for _, handler := range f.subscribers(topic) {
+		handler(f, msg)
+	}
+}
+
+// Subscribed report whether any handler is subscribed to topic.
+func (f *Fake) Subscribed(topic string) bool {
+	return len(f.subscribers(topic)) > 0
+}
+
+// Sent return commands sent to vin.
+func (f *Fake) Sent(vin int) []*sdk.CommandPacket {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cmds := []*sdk.CommandPacket{}
+	for _, cmd := range f.sent {
+		if int(cmd.Vin) == vin {
+			cmds = append(cmds, cmd)
+		}
+	}
+	return cmds
+}
+
+// IsConnected implements mqtt.Client.
+func (f *Fake) IsConnected() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.connected
+}
+
+// IsConnectionOpen implements mqtt.Client.
+func (f *Fake) IsConnectionOpen() bool {
+	return f.IsConnected()
+}
+
+// Connect implements mqtt.Client.
+func (f *Fake) Connect() mqtt.Token {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.connected = true
+	return &mqtt.DummyToken{}
+}
+
+// Disconnect implements mqtt.Client.
+func (f *Fake) Disconnect(quiesce uint) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.connected = false
+}
+
+// Publish implements mqtt.Client, command is answered in background.
+func (f *Fake) Publish(topic string, qos byte, retained bool, payload interface{}) mqtt.Token {
+	var b []byte
+	switch p := payload.(type) {
+	case []byte:
+		b = p
+	case string:
+		b = []byte(p)
+	}
+
+	// empty payload only flush retained message
+	if len(b) == 0 {
+		return &mqtt.DummyToken{}
+	}
+
+	f.Emit(topic, b)
+	if _, ok := sdk.VinOf(f.topics().Command, topic); ok {
+		f.command(b)
+	}
+	return &mqtt.DummyToken{}
+}
+
+// Subscribe implements mqtt.Client.
+func (f *Fake) Subscribe(topic string, qos byte, callback mqtt.MessageHandler) mqtt.Token {
+	return f.SubscribeMultiple(map[string]byte{topic: qos}, callback)
+}
+
+// SubscribeMultiple implements mqtt.Client, nil callback keep handlers of the filters
+// (ex: resubscribe), as paho does.
+func (f *Fake) SubscribeMultiple(filters map[string]byte, callback mqtt.MessageHandler) mqtt.Token {
+	for topic := range filters {
+		f.AddRoute(topic, callback)
+	}
+	return &mqtt.DummyToken{}
+}
+
+// Unsubscribe implements mqtt.Client.
+func (f *Fake) Unsubscribe(topics ...string) mqtt.Token {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, topic := range topics {
+		delete(f.handlers, topic)
+	}
+	return &mqtt.DummyToken{}
+}
+
+// AddRoute implements mqtt.Client, callback is added to handlers of topic.
+func (f *Fake) AddRoute(topic string, callback mqtt.MessageHandler) {
+	if callback == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[topic] = append(f.handlers[topic], callback)
+}
+
+// OptionsReader implements mqtt.Client.
+func (f *Fake) OptionsReader() mqtt.ClientOptionsReader {
+	return mqtt.ClientOptionsReader{}
+}
+
+// command decode published command, record it and reply as expected.
+func (f *Fake) command(b []byte) {
+	cmd, err := sdk.DecodeCommand(b)
+	if err != nil {
+		return
+	}
+
+	f.mu.Lock()
+	f.sent = append(f.sent, cmd)
+	e := f.expectation(int(cmd.Vin), cmd.Invoker)
+	if e == nil {
+		f.unexpected = append(f.unexpected, cmd)
+		f.mu.Unlock()
+		return
+	}
+	e.times--
+	replies := e.replies(cmd)
+	f.mu.Unlock()
+
+	resTopic := sdk.TopicOf(f.topics().Response, int(cmd.Vin))
+	go func() {
+		for _, reply := range replies {
+			time.Sleep(f.Latency)
+			f.Emit(resTopic, reply)
+		}
+	}()
+}
+
+// expectation find pending expectation of command.
+func (f *Fake) expectation(vin int, invoker string) *Expectation {
+	for _, e := range f.expectations {
+		if e.vin == vin && e.invoker == invoker && e.times > 0 {
+			return e
+		}
+	}
+	return nil
+}
+
+// subscribers find handlers matched topic.
+func (f *Fake) subscribers(topic string) []mqtt.MessageHandler {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	handlers := []mqtt.MessageHandler{}
+	for filter, hs := range f.handlers {
+		if broker.Match(filter, topic) {
+			handlers = append(handlers, hs...)
+		}
+	}
+	return handlers
+}
+
+// topics return resolved Topics, invalid layout is reported by Sdk on connect.
+func (f *Fake) topics() sdk.TopicScheme {
+	ts, err := f.Topics.Resolve()
+	if err != nil {
+		return sdk.PrefixTopics("")
+	}
+	return ts
+}
+
+// sleeper skip commander delay, and use fake timeout.
+type sleeper struct {
+	fake *Fake
+}
+
+func (s *sleeper) Sleep(d time.Duration) {}
+
+func (s *sleeper) After(d time.Duration) <-chan time.Time {
+	return time.After(s.fake.Timeout)
+}
+
+// message implements mqtt.Message.
+type message struct {
+	topic   string
+	payload []byte
+}
+
+func (m *message) Duplicate() bool   { return false }
+func (m *message) Qos() byte         { return 1 }
+func (m *message) Retained() bool    { return false }
+func (m *message) Topic() string     { return m.topic }
+func (m *message) MessageID() uint16 { return 0 }
+func (m *message) Payload() []byte   { return m.payload }
+func (m *message) Ack()              {}
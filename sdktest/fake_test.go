@@ -0,0 +1,247 @@
+package sdktest_test
+
+import (
+	"testing"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"github.com/garda-energi/gen.vcu.sdk/sdktest"
+)
+
+const testVin = 354313
+
+func TestFakeCommand(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		expect func(e *sdktest.Expectation)
+		want   string
+	}{
+		{
+			desc:   "respond ok",
+			expect: func(e *sdktest.Expectation) { e.RespondOK() },
+			want:   "",
+		},
+		{
+			desc:   "respond error",
+			expect: func(e *sdktest.Expectation) { e.RespondError("Busy") },
+			want:   sdk.ResCodeError.String() + " Busy",
+		},
+		{
+			desc:   "no ack",
+			expect: func(e *sdktest.Expectation) { e.NoAck() },
+			want:   "packet ack timeout",
+		},
+		{
+			desc:   "no response",
+			expect: func(e *sdktest.Expectation) { e.NoResponse() },
+			want:   "packet response timeout",
+		},
+		{
+			desc:   "corrupt ack",
+			expect: func(e *sdktest.Expectation) { e.RespondRaw([]byte("X")) },
+			want:   "packet ack corrupt",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			fake := sdktest.New()
+			api := newFakeApi(t, fake)
+
+			tC.expect(fake.ExpectCommand(testVin, "GenLed"))
+
+			cmder, err := api.NewCommander(testVin)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			defer cmder.Destroy()
+
+			got := ""
+			if err := cmder.GenLed(true); err != nil {
+				got = err.Error()
+			}
+			if got != tC.want {
+				t.Errorf("want %q, got %q", tC.want, got)
+			}
+
+			fake.AssertExpectations(t)
+			fake.AssertCommandSent(t, testVin, "GenLed")
+		})
+	}
+}
+
+func TestFakeCommandMessage(t *testing.T) {
+	fake := sdktest.New()
+	api := newFakeApi(t, fake)
+
+	fake.ExpectCommand(testVin, "GenInfo").
+		RespondWith(sdk.ResCodeOk, []byte("VCU v.1")).
+		Times(2)
+
+	cmder, err := api.NewCommander(testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cmder.Destroy()
+
+	for i := 0; i < 2; i++ {
+		got, err := cmder.GenInfo()
+		if err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+		if want := "VCU v.1"; got != want {
+			t.Errorf("want %s, got %s", want, got)
+		}
+	}
+
+	sent := fake.Sent(testVin)
+	if len(sent) != 2 {
+		t.Fatalf("want %d commands, got %d", 2, len(sent))
+	}
+	fake.AssertExpectations(t)
+}
+
+func TestFakeUnexpectedCommand(t *testing.T) {
+	fake := sdktest.New()
+	api := newFakeApi(t, fake)
+
+	fake.ExpectCommand(testVin, "GenInfo")
+
+	cmder, err := api.NewCommander(testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cmder.Destroy()
+
+	_ = cmder.GenLed(true)
+
+	spy := &spyT{TB: t}
+	if fake.AssertExpectations(spy) {
+		t.Error("want expectations failed")
+	}
+	if want := 2; spy.errors != want {
+		t.Errorf("want %d errors, got %d", want, spy.errors)
+	}
+}
+
+func TestFakeListener(t *testing.T) {
+	fake := sdktest.New()
+	api := newFakeApi(t, fake)
+
+	statuses := []bool{}
+	reports := []*sdk.ReportPacket{}
+	_, err := api.AddListener(sdk.Listener{
+		StatusFunc: func(vin int, online bool) {
+			statuses = append(statuses, online)
+		},
+		ReportFunc: func(vin int, report *sdk.ReportPacket) {
+			reports = append(reports, report)
+		},
+	}, testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	fake.AssertSubscribed(t, "VCU/354313/RPT", true)
+
+	fake.SetOnline(testVin, true)
+	fake.SetOnline(testVin, false)
+	if len(statuses) != 2 || !statuses[0] || statuses[1] {
+		t.Errorf("want %v, got %v", []bool{true, false}, statuses)
+	}
+
+	rp, err := sdk.NewReportBuilder(1, testVin).Build()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if err := fake.EmitReport(testVin, rp); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	// other vin is not subscribed
+	_ = fake.EmitReport(testVin+1, rp)
+
+	if len(reports) != 1 {
+		t.Fatalf("want %d report, got %d", 1, len(reports))
+	}
+	if got := int(reports[0].Header.Vin); got != testVin {
+		t.Errorf("want %d, got %d", testVin, got)
+	}
+
+	if err := api.RemoveListener(testVin); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	fake.AssertSubscribed(t, "VCU/354313/RPT", false)
+}
+
+func TestFakeTopics(t *testing.T) {
+	fake := sdktest.New()
+	fake.Topics = sdk.PrefixTopics("stg")
+	api := newFakeApi(t, fake)
+
+	online := false
+	_, err := api.AddListener(sdk.Listener{
+		StatusFunc: func(vin int, o bool) { online = o },
+	}, testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	fake.AssertSubscribed(t, "stg/VCU/354313/STS", true)
+	fake.AssertSubscribed(t, "VCU/354313/STS", false)
+
+	fake.SetOnline(testVin, true)
+	if !online {
+		t.Error("want status on stg topic")
+	}
+
+	fake.ExpectCommand(testVin, "GenLed").RespondOK()
+	cmder, err := api.NewCommander(testVin)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer cmder.Destroy()
+
+	if err := cmder.GenLed(true); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	fake.AssertExpectations(t)
+}
+
+func TestFakeHandlers(t *testing.T) {
+	fake := sdktest.New()
+
+	got := 0
+	handler := func(c mqtt.Client, msg mqtt.Message) { got++ }
+	fake.Subscribe("VCU/+/STS", 1, handler)
+	fake.Subscribe("VCU/+/STS", 1, handler)
+	// resubscribe keep the handlers
+	fake.Subscribe("VCU/+/STS", 1, nil)
+
+	fake.SetOnline(testVin, true)
+	if got != 2 {
+		t.Errorf("want %d, got %d", 2, got)
+	}
+
+	fake.Unsubscribe("VCU/+/STS")
+	fake.AssertSubscribed(t, "VCU/354313/STS", false)
+}
+
+func newFakeApi(t *testing.T, fake *sdktest.Fake) *sdk.Sdk {
+	t.Helper()
+
+	api := fake.Sdk()
+	if err := api.Connect(); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	t.Cleanup(api.Disconnect)
+	return &api
+}
+
+// spyT count reported errors instead of failing the test.
+type spyT struct {
+	testing.TB
+	errors int
+}
+
+func (s *spyT) Helper() {}
+
+func (s *spyT) Errorf(format string, args ...interface{}) {
+	s.errors++
+}
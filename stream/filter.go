@@ -0,0 +1,99 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+// Filter select events sent to subscriber, empty field means all.
+// Fields project report to selected GetValue paths, ex: "Gps.Longitude".
+type Filter struct {
+	Vins   []int    `json:"vins,omitempty"`
+	Events []string `json:"events,omitempty"`
+	Fields []string `json:"fields,omitempty"`
+
+	vins   map[int]bool
+	events map[string]bool
+}
+
+// ParseFilter read filter from query: vins, events & fields, comma separated.
+// VIN range is allowed, ex: vins=354313-354320.
+func ParseFilter(q url.Values) (Filter, error) {
+	f := Filter{}
+	for _, part := range splitList(q.Get("vins")) {
+		bounds := strings.SplitN(part, "-", 2)
+		min, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return f, fmt.Errorf("invalid vin %q", part)
+		}
+		max := min
+		if len(bounds) == 2 {
+			if max, err = strconv.Atoi(bounds[1]); err != nil {
+				return f, fmt.Errorf("invalid vin range %q", part)
+			}
+		}
+		f.Vins = append(f.Vins, sdk.VinRange(min, max)...)
+	}
+	f.Events = splitList(q.Get("events"))
+	f.Fields = splitList(q.Get("fields"))
+	return f, f.validate()
+}
+
+func (f *Filter) validate() error {
+	for _, ev := range f.Events {
+		if ev != EVENT_STATUS && ev != EVENT_REPORT {
+			return fmt.Errorf("invalid event %q", ev)
+		}
+	}
+	return nil
+}
+
+// compile build lookup set of filter.
+func (f *Filter) compile() {
+	f.vins = map[int]bool{}
+	for _, vin := range f.Vins {
+		f.vins[vin] = true
+	}
+	f.events = map[string]bool{}
+	for _, ev := range f.Events {
+		f.events[ev] = true
+	}
+}
+
+func (f *Filter) match(vin int, event string) bool {
+	if len(f.vins) > 0 && !f.vins[vin] {
+		return false
+	}
+	if len(f.events) > 0 && !f.events[event] {
+		return false
+	}
+	return true
+}
+
+// project build object of selected path & its value, missing path is null.
+func project(rp *sdk.ReportPacket, fields []string) json.RawMessage {
+	values := make(map[string]interface{}, len(fields))
+	for _, path := range fields {
+		values[path] = rp.GetValue(path)
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func splitList(s string) []string {
+	list := []string{}
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, part)
+		}
+	}
+	return list
+}
@@ -0,0 +1,145 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// WRITE_TIMEOUT is maximum time to write an event to client.
+	WRITE_TIMEOUT = 10 * time.Second
+	// PING_INTERVAL is interval of keep-alive (websocket ping & sse comment).
+	PING_INTERVAL = 30 * time.Second
+)
+
+// ServeHTTP stream events as WebSocket when upgrade is requested, otherwise as SSE.
+// Filter is read from query, see ParseFilter.
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f, err := ParseFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if websocket.IsWebSocketUpgrade(r) {
+		h.serveWebSocket(w, r, f)
+		return
+	}
+	h.serveSSE(w, r, f)
+}
+
+// serveSSE write events as text/event-stream.
+func (h *Hub) serveSSE(w http.ResponseWriter, r *http.Request, f Filter) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	sub, err := h.Subscribe(f)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ping := time.NewTicker(PING_INTERVAL)
+	defer ping.Stop()
+
+	for {
+		select {
+		case msg := <-sub.Events():
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", msg.Type, msg.Data); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-ping.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-sub.Done():
+			fmt.Fprintf(w, "event: close\ndata: %q\n\n", sub.Err().Error())
+			flusher.Flush()
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// serveWebSocket write events as text message. Client may send filter
+// as json message, ex: {"vins": [354313], "fields": ["Bms.SOC"]}, to replace current one.
+func (h *Hub) serveWebSocket(w http.ResponseWriter, r *http.Request, f Filter) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: h.CheckOrigin,
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	sub, err := h.Subscribe(f)
+	if err != nil {
+		conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()),
+			time.Now().Add(WRITE_TIMEOUT))
+		return
+	}
+	defer sub.Close()
+
+	// reader handle filter update & close from client
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			_, b, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			nf := Filter{}
+			if err := json.Unmarshal(b, &nf); err != nil {
+				continue
+			}
+			if err := nf.validate(); err != nil {
+				continue
+			}
+			sub.SetFilter(nf)
+		}
+	}()
+
+	ping := time.NewTicker(PING_INTERVAL)
+	defer ping.Stop()
+
+	for {
+		select {
+		case msg := <-sub.Events():
+			conn.SetWriteDeadline(time.Now().Add(WRITE_TIMEOUT))
+			if err := conn.WriteMessage(websocket.TextMessage, msg.Data); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(WRITE_TIMEOUT)); err != nil {
+				return
+			}
+		case <-sub.Done():
+			conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.ClosePolicyViolation, sub.Err().Error()),
+				time.Now().Add(WRITE_TIMEOUT))
+			return
+		case <-closed:
+			return
+		}
+	}
+}
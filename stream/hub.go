@@ -0,0 +1,261 @@
+// Package stream fan out status & report of Listener callbacks to many
+// subscribers over WebSocket or SSE (Server-Sent Events).
+//
+// Each subscriber can filter VINs & event types, and project report to selected
+// GetValue paths. Subscriber which can't keep up with the stream is dropped.
+//
+//	hub := stream.NewHub(0)
+//	api.AddListener(hub.Listener())
+//	http.Handle("/stream", hub)
+//
+// Query of stream endpoint: vins=354313,354314&fields=Gps.Longitude,Bms.SOC&events=report
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+)
+
+const (
+	// DEFAULT_BUFFER is number of events queued per subscriber before it is dropped.
+	DEFAULT_BUFFER = 64
+
+	EVENT_STATUS = "status"
+	EVENT_REPORT = "report"
+)
+
+var (
+	errHubClosed    = errors.New("hub closed")
+	errSlowConsumer = errors.New("slow consumer dropped")
+	errUnsubscribed = errors.New("unsubscribed")
+)
+
+// Event is payload sent to subscriber.
+// Report is ReportPacket.Json(), or object of selected path & value when projected.
+type Event struct {
+	Type   string          `json:"type"`
+	Vin    int             `json:"vin"`
+	Time   time.Time       `json:"time"`
+	Online *bool           `json:"online,omitempty"`
+	Report json.RawMessage `json:"report,omitempty"`
+}
+
+// Message is encoded event sent to subscriber, Data is json of Event.
+type Message struct {
+	Type string
+	Data []byte
+}
+
+// Stats is counter of hub.
+type Stats struct {
+	Subscribers int    `json:"subscribers"`
+	Dropped     uint64 `json:"dropped"`
+}
+
+// Hub broadcast events to subscribers.
+// CheckOrigin is used on WebSocket upgrade, nil only allow same origin.
+type Hub struct {
+	CheckOrigin func(r *http.Request) bool
+
+	buffer  int
+	mutex   *sync.RWMutex
+	subs    map[*Subscription]struct{}
+	closed  bool
+	dropped uint64
+}
+
+// NewHub create hub, buffer is queue size per subscriber (DEFAULT_BUFFER if zero).
+func NewHub(buffer int) *Hub {
+	if buffer <= 0 {
+		buffer = DEFAULT_BUFFER
+	}
+	return &Hub{
+		buffer: buffer,
+		mutex:  &sync.RWMutex{},
+		subs:   map[*Subscription]struct{}{},
+	}
+}
+
+// Listener return listener feeding the hub, to be added on Sdk.
+func (h *Hub) Listener() sdk.Listener {
+	return sdk.Listener{
+		StatusFunc: h.Status,
+		ReportFunc: h.Report,
+	}
+}
+
+// Status broadcast status of VIN.
+func (h *Hub) Status(vin int, online bool) {
+	ev := Event{
+		Type:   EVENT_STATUS,
+		Vin:    vin,
+		Time:   time.Now(),
+		Online: &online,
+	}
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return
+	}
+
+	h.broadcast(vin, EVENT_STATUS, func(f *Filter) []byte {
+		return b
+	})
+}
+
+// Report broadcast report of VIN. Full report is encoded once for all subscribers.
+func (h *Hub) Report(vin int, rp *sdk.ReportPacket) {
+	now := time.Now()
+
+	var full []byte
+	h.broadcast(vin, EVENT_REPORT, func(f *Filter) []byte {
+		ev := Event{
+			Type: EVENT_REPORT,
+			Vin:  vin,
+			Time: now,
+		}
+		if len(f.Fields) == 0 {
+			if full == nil {
+				ev.Report = rp.Json()
+				full, _ = json.Marshal(ev)
+			}
+			return full
+		}
+
+		ev.Report = project(rp, f.Fields)
+		b, _ := json.Marshal(ev)
+		return b
+	})
+}
+
+// broadcast send event to each matched subscriber, without blocking.
+func (h *Hub) broadcast(vin int, event string, encode func(f *Filter) []byte) {
+	h.mutex.RLock()
+	slow := []*Subscription{}
+	for sub := range h.subs {
+		f := sub.Filter()
+		if !f.match(vin, event) {
+			continue
+		}
+		b := encode(f)
+		if b == nil {
+			continue
+		}
+
+		select {
+		case sub.events <- Message{Type: event, Data: b}:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	h.mutex.RUnlock()
+
+	for _, sub := range slow {
+		h.remove(sub, errSlowConsumer)
+	}
+}
+
+// Subscribe register new subscriber. Call Close when done.
+func (h *Hub) Subscribe(f Filter) (*Subscription, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.closed {
+		return nil, errHubClosed
+	}
+
+	sub := &Subscription{
+		hub:    h,
+		events: make(chan Message, h.buffer),
+		done:   make(chan struct{}),
+	}
+	sub.SetFilter(f)
+	h.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// remove unregister subscriber with reason.
+func (h *Hub) remove(sub *Subscription, reason error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	if reason == errSlowConsumer {
+		atomic.AddUint64(&h.dropped, 1)
+	}
+	sub.err = reason
+	close(sub.done)
+}
+
+// Stats return current subscribers & total dropped subscribers.
+func (h *Hub) Stats() Stats {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return Stats{
+		Subscribers: len(h.subs),
+		Dropped:     atomic.LoadUint64(&h.dropped),
+	}
+}
+
+// Close disconnect all subscribers, new subscriber is rejected.
+func (h *Hub) Close() {
+	h.mutex.Lock()
+	subs := h.subs
+	h.subs = map[*Subscription]struct{}{}
+	h.closed = true
+	h.mutex.Unlock()
+
+	for sub := range subs {
+		sub.err = errHubClosed
+		close(sub.done)
+	}
+}
+
+// Subscription receive matched events until closed or dropped.
+type Subscription struct {
+	hub    *Hub
+	filter atomic.Value
+	events chan Message
+	done   chan struct{}
+	err    error
+}
+
+// Events return encoded events.
+func (s *Subscription) Events() <-chan Message {
+	return s.events
+}
+
+// Done is closed when subscription is closed or dropped, see Err.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err return reason of closed subscription, valid after Done is closed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Filter return current filter.
+func (s *Subscription) Filter() *Filter {
+	return s.filter.Load().(*Filter)
+}
+
+// SetFilter replace filter, it is applied to next events.
+func (s *Subscription) SetFilter(f Filter) {
+	f.compile()
+	s.filter.Store(&f)
+}
+
+// Close unregister subscription.
+func (s *Subscription) Close() {
+	s.hub.remove(s, errUnsubscribed)
+}
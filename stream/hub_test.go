@@ -0,0 +1,252 @@
+package stream_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/garda-energi/gen.vcu.sdk"
+	"github.com/garda-energi/gen.vcu.sdk/stream"
+	"github.com/gorilla/websocket"
+)
+
+const testVin = 354313
+
+func TestHubFilter(t *testing.T) {
+	rp := newReport(t, testVin)
+
+	testCases := []struct {
+		desc   string
+		filter stream.Filter
+		vin    int
+		want   string
+	}{
+		{
+			desc:   "all",
+			filter: stream.Filter{},
+			vin:    testVin,
+			want:   string(rp.Json()),
+		},
+		{
+			desc:   "other vin",
+			filter: stream.Filter{Vins: []int{1}},
+			vin:    testVin,
+			want:   "",
+		},
+		{
+			desc:   "status only",
+			filter: stream.Filter{Events: []string{stream.EVENT_STATUS}},
+			vin:    testVin,
+			want:   "",
+		},
+		{
+			desc:   "projected",
+			filter: stream.Filter{Vins: []int{testVin}, Fields: []string{"Bms.SOC", "Bms.Pack.[9].SOC"}},
+			vin:    testVin,
+			want:   `{"Bms.Pack.[9].SOC":null,"Bms.SOC":80}`,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			hub := stream.NewHub(0)
+			defer hub.Close()
+
+			sub, err := hub.Subscribe(tC.filter)
+			if err != nil {
+				t.Fatal("want no error, got ", err)
+			}
+			hub.Report(tC.vin, rp)
+
+			select {
+			case msg := <-sub.Events():
+				ev := decodeEvent(t, msg.Data)
+				if ev.Type != stream.EVENT_REPORT || ev.Vin != tC.vin {
+					t.Errorf("want %s of %d, got %s of %d", stream.EVENT_REPORT, tC.vin, ev.Type, ev.Vin)
+				}
+				if string(ev.Report) != tC.want {
+					t.Errorf("want %s, got %s", tC.want, ev.Report)
+				}
+			default:
+				if tC.want != "" {
+					t.Error("want event, got nothing")
+				}
+			}
+		})
+	}
+}
+
+func TestHubSlowConsumer(t *testing.T) {
+	hub := stream.NewHub(1)
+	defer hub.Close()
+
+	slow, _ := hub.Subscribe(stream.Filter{})
+	fast, _ := hub.Subscribe(stream.Filter{})
+
+	hub.Status(testVin, true)
+	<-fast.Events()
+	hub.Status(testVin, false)
+
+	select {
+	case <-slow.Done():
+	default:
+		t.Fatal("want slow consumer dropped")
+	}
+	if slow.Err() == nil {
+		t.Error("want error, got nil")
+	}
+	if stats := hub.Stats(); stats.Subscribers != 1 || stats.Dropped != 1 {
+		t.Errorf("want 1 subscriber & 1 dropped, got %+v", stats)
+	}
+}
+
+func TestHubSSE(t *testing.T) {
+	hub := stream.NewHub(0)
+	srv := httptest.NewServer(hub)
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "?vins=354313-354314&events=status")
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("want text/event-stream, got %s", ct)
+	}
+	waitSubscribers(t, hub, 1)
+
+	hub.Report(testVin, newReport(t, testVin))
+	hub.Status(testVin+1, true)
+
+	r := bufio.NewReader(res.Body)
+	event, data := readSSE(t, r)
+	if event != stream.EVENT_STATUS {
+		t.Errorf("want %s, got %s", stream.EVENT_STATUS, event)
+	}
+	if ev := decodeEvent(t, data); ev.Vin != testVin+1 || ev.Online == nil || !*ev.Online {
+		t.Errorf("want online %d, got %s", testVin+1, data)
+	}
+
+	hub.Close()
+	if event, _ := readSSE(t, r); event != "close" {
+		t.Errorf("want close, got %s", event)
+	}
+}
+
+func TestHubWebSocket(t *testing.T) {
+	hub := stream.NewHub(0)
+	defer hub.Close()
+	srv := httptest.NewServer(hub)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "?events=status"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	defer conn.Close()
+	waitSubscribers(t, hub, 1)
+
+	messages := make(chan []byte, 16)
+	go func() {
+		defer close(messages)
+		for {
+			_, b, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			messages <- b
+		}
+	}()
+
+	hub.Status(testVin, true)
+	select {
+	case b := <-messages:
+		if ev := decodeEvent(t, b); ev.Type != stream.EVENT_STATUS {
+			t.Errorf("want %s, got %s", stream.EVENT_STATUS, ev.Type)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("want status, got nothing")
+	}
+
+	// replace filter from client, applied asynchronously
+	err = conn.WriteJSON(stream.Filter{Events: []string{stream.EVENT_REPORT}, Fields: []string{"Bms.SOC"}})
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	rp := newReport(t, testVin)
+	for start := time.Now(); time.Since(start) < 2*time.Second; {
+		hub.Report(testVin, rp)
+
+		select {
+		case b := <-messages:
+			if ev := decodeEvent(t, b); ev.Type == stream.EVENT_REPORT {
+				if string(ev.Report) != `{"Bms.SOC":80}` {
+					t.Errorf("want projected report, got %s", ev.Report)
+				}
+				return
+			}
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	t.Error("want report after filter replaced")
+}
+
+func newReport(t *testing.T, vin int) *sdk.ReportPacket {
+	t.Helper()
+
+	rp, err := sdk.NewReportBuilder(1, vin).Set("Bms.SOC", 80).Build()
+	if err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	return rp
+}
+
+func decodeEvent(t *testing.T, b []byte) stream.Event {
+	t.Helper()
+
+	var ev stream.Event
+	if err := json.Unmarshal(b, &ev); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	// compact, so report is comparable with ReportPacket.Json()
+	var buf bytes.Buffer
+	json.Compact(&buf, ev.Report)
+	ev.Report = buf.Bytes()
+	return ev
+}
+
+func readSSE(t *testing.T, r *bufio.Reader) (event string, data []byte) {
+	t.Helper()
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal("want no error, got ", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = []byte(strings.TrimPrefix(line, "data: "))
+		case line == "" && event != "":
+			return event, data
+		}
+	}
+}
+
+func waitSubscribers(t *testing.T, hub *stream.Hub, n int) {
+	t.Helper()
+
+	for start := time.Now(); time.Since(start) < 2*time.Second; time.Sleep(5 * time.Millisecond) {
+		if hub.Stats().Subscribers == n {
+			return
+		}
+	}
+	t.Fatalf("want %d subscriber(s), got %d", n, hub.Stats().Subscribers)
+}
@@ -13,7 +13,8 @@ type cmdChan chan packet
 type stsChan chan packet
 type repChan chan packets
 
-// stubMqttClient implements stub mqtt client stub
+// stubMqttClient implements stub mqtt client stub, it is internal counterpart of
+// sdktest.Fake which can't be imported here (import cycle).
 type stubMqttClient struct {
 	mqtt.Client
 	connected bool
@@ -249,6 +250,12 @@ func (m *stubMessage) Topic() string {
 func (m *stubMessage) Payload() []byte {
 	return m.payload
 }
+func (m *stubMessage) Qos() byte {
+	return 1
+}
+func (m *stubMessage) Retained() bool {
+	return false
+}
 
 // stubSleeper implement fake sleeper stub
 type stubSleeper struct {
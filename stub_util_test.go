@@ -2,20 +2,22 @@ package sdk
 
 import (
 	"log"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 )
 
 func newStubApi() *Sdk {
-	logger := newLogger(false, "TEST")
+	logger := newLogger(nil, false)
 	return &Sdk{
 		logger: logger,
 		client: newStubClient(logger, false),
 	}
 }
 
-func newStubClient(l *log.Logger, connected bool) *client {
+func newStubClient(l *logger, connected bool) *client {
 	stubClient :=
 		&stubMqttClient{
 			connected: connected,
@@ -33,11 +35,11 @@ func newStubClient(l *log.Logger, connected bool) *client {
 			},
 		}
 
-	return newFakeClient(stubClient, l)
+	return newFakeClient(stubClient, &ClientConfig{}, l)
 }
 
 func newStubCommander(vin int) *commander {
-	logger := newLogger(false, "TEST")
+	logger := newLogger(nil, false)
 	client := newStubClient(logger, true)
 	sleeper := &stubSleeper{
 		sleep: time.Millisecond,
@@ -76,3 +78,28 @@ func assertSubscribed(t *testing.T, api *Sdk, subscribed bool, vins []int) {
 		}
 	}
 }
+
+// toGlobalTopic replace VIN from topic with '+' character
+func toGlobalTopic(topic string) string {
+	s := strings.Split(topic, "/")
+	if len(s) < 2 {
+		return topic
+	}
+	s[1] = "+"
+	return strings.Join(s, "/")
+}
+
+// getTopicVin extract VIN information from mqtt topic
+func getTopicVin(topic string) int {
+	s := strings.Split(topic, "/")
+	if len(s) < 2 {
+		return 0
+	}
+	vin, _ := strconv.Atoi(s[1])
+	return vin
+}
+
+// setTopicVin insert VIN into topic pattern
+func setTopicVin(topic string, vin int) string {
+	return strings.Replace(topic, "+", strconv.Itoa(vin), 1)
+}
@@ -6,7 +6,10 @@ import (
 
 const SDK_VERSION = 123
 
+// TOPIC_* is default topic layout, see TopicScheme.
+// TOPIC_VIN is VIN placeholder of topic templates.
 const (
+	TOPIC_VIN      = "{vin}"
 	TOPIC_STATUS   = "VCU/+/STS"
 	TOPIC_REPORT   = "VCU/+/RPT"
 	TOPIC_COMMAND  = "VCU/+/CMD"
@@ -22,6 +25,24 @@ const (
 	QOS_CMD_FLUSH    = 1
 )
 
+const (
+	DEFAULT_KEEP_ALIVE             = 30 * time.Second
+	DEFAULT_CONNECT_TIMEOUT        = 10 * time.Second
+	DEFAULT_MAX_RECONNECT_INTERVAL = time.Minute
+	DEFAULT_RETRY_INTERVAL         = time.Second
+	// RESUBSCRIBE_BATCH_MAX is maximum topics of one subscribe packet on resubscribe.
+	RESUBSCRIBE_BATCH_MAX = 100
+	// CLIENT_ID_PREFIX is prefix of random client id.
+	CLIENT_ID_PREFIX = "vcu_sdk_"
+	// SESSION_PENDING_MAX is maximum messages of persistent session
+	// kept until its listener is added.
+	SESSION_PENDING_MAX = 1000
+	// DEFAULT_DISPATCH_QUEUE is buffered messages per dispatch worker.
+	DEFAULT_DISPATCH_QUEUE = 256
+	// CORRELATION_DATA_LEN is random bytes of command correlation data on MQTT 5.
+	CORRELATION_DATA_LEN = 8
+)
+
 const (
 	PREFIX_ACK      = "@A"
 	PREFIX_REPORT   = "@T"
@@ -75,6 +96,20 @@ const (
 	REPORT_INTERVAL_MAX = time.Duration(^uint16(0)) * time.Second
 )
 
+const (
+	REPORT_DATETIME_YEAR_MIN = 2021
+	REPORT_DATETIME_SKEW_MAX = 24 * time.Hour
+)
+
+// RECORD_MAGIC is the first bytes of recorded file, followed by format version.
+// REPLAY_SPEED_MAX replay records as fast as possible, ignoring recorded interval.
+const (
+	RECORD_MAGIC       = "VCUREC"
+	RECORD_VERSION     = 1
+	RECORD_PAYLOAD_MAX = 1 << 20
+	REPLAY_SPEED_MAX   = 0
+)
+
 const (
 	GPS_DOP_MIN = 5
 	GPS_LNG_MIN = 95.011198
@@ -1,5 +1,10 @@
 package sdk
 
+import (
+	"strconv"
+	"strings"
+)
+
 type VarDataType string
 
 const (
@@ -35,6 +40,18 @@ func (m Frame) String() string {
 	}[m]
 }
 
+// UnmarshalText parse Frame from its name (case insensitive) or number.
+func (m *Frame) UnmarshalText(text []byte) error {
+	v, err := parseEnum(text, int(FrameSimple), int(FrameLimit), func(i int) string {
+		return Frame(i).String()
+	})
+	if err != nil {
+		return errInputOutOfRange("frame")
+	}
+	*m = Frame(v)
+	return nil
+}
+
 type BikeState int8
 
 const (
@@ -60,6 +77,18 @@ func (m BikeState) String() string {
 	}[m+3]
 }
 
+// UnmarshalText parse BikeState from its name (case insensitive) or number.
+func (m *BikeState) UnmarshalText(text []byte) error {
+	v, err := parseEnum(text, int(BikeStateUnknown), int(BikeStateLimit), func(i int) string {
+		return BikeState(i).String()
+	})
+	if err != nil {
+		return errInputOutOfRange("bike-state")
+	}
+	*m = BikeState(v)
+	return nil
+}
+
 type ModeSub uint8
 
 const (
@@ -94,6 +123,18 @@ func (m ModeDrive) String() string {
 	}[m]
 }
 
+// UnmarshalText parse ModeDrive from its name (case insensitive) or number.
+func (m *ModeDrive) UnmarshalText(text []byte) error {
+	v, err := parseEnum(text, int(ModeDriveEconomy), int(ModeDriveLimit), func(i int) string {
+		return ModeDrive(i).String()
+	})
+	if err != nil {
+		return errInputOutOfRange("drive-mode")
+	}
+	*m = ModeDrive(v)
+	return nil
+}
+
 type ModeTrip uint8
 
 const (
@@ -111,6 +152,18 @@ func (m ModeTrip) String() string {
 	}[m]
 }
 
+// UnmarshalText parse ModeTrip from its name (case insensitive) or number.
+func (m *ModeTrip) UnmarshalText(text []byte) error {
+	v, err := parseEnum(text, int(ModeTripOdo), int(ModeTripLimit), func(i int) string {
+		return ModeTrip(i).String()
+	})
+	if err != nil {
+		return errInputOutOfRange("trip-mode")
+	}
+	*m = ModeTrip(v)
+	return nil
+}
+
 type ModeAvg uint8
 
 const (
@@ -126,6 +179,18 @@ func (m ModeAvg) String() string {
 	}[m]
 }
 
+// UnmarshalText parse ModeAvg from its name (case insensitive) or number.
+func (m *ModeAvg) UnmarshalText(text []byte) error {
+	v, err := parseEnum(text, int(ModeAvgRange), int(ModeAvgLimit), func(i int) string {
+		return ModeAvg(i).String()
+	})
+	if err != nil {
+		return errInputOutOfRange("avg-mode")
+	}
+	*m = ModeAvg(v)
+	return nil
+}
+
 func (m ModeAvg) Unit() string {
 	return [...]string{
 		"KM",
@@ -160,16 +225,88 @@ func (m NetState) String() string {
 	}[m+1]
 }
 
-type resCode uint8
+type ReportPolicy uint8
+
+const (
+	ReportPolicyLenient ReportPolicy = iota
+	ReportPolicyStrict
+	ReportPolicyLimit
+)
+
+func (m ReportPolicy) String() string {
+	return [...]string{
+		"LENIENT",
+		"STRICT",
+	}[m]
+}
+
+type QueuePolicy uint8
+
+const (
+	QueuePolicyBlock QueuePolicy = iota
+	QueuePolicyDropNewest
+	QueuePolicyDropOldest
+	QueuePolicyLimit
+)
+
+func (m QueuePolicy) String() string {
+	return [...]string{
+		"BLOCK",
+		"DROP_NEWEST",
+		"DROP_OLDEST",
+	}[m]
+}
+
+type ConnState uint8
 
 const (
-	resCodeError resCode = iota
-	resCodeOk
-	resCodeInvalid
-	resCodeLimit
+	ConnStateDisconnected ConnState = iota
+	ConnStateConnecting
+	ConnStateConnected
+	ConnStateReconnecting
+	ConnStateLimit
 )
 
-func (m resCode) String() string {
+func (m ConnState) String() string {
+	return [...]string{
+		"DISCONNECTED",
+		"CONNECTING",
+		"CONNECTED",
+		"RECONNECTING",
+	}[m]
+}
+
+type PacketKind uint8
+
+const (
+	PacketKindUnknown PacketKind = iota
+	PacketKindReport
+	PacketKindCommand
+	PacketKindAck
+	PacketKindResponse
+	PacketKindLimit
+)
+
+func (m PacketKind) String() string {
+	return [...]string{
+		"UNKNOWN",
+		"REPORT",
+		"COMMAND",
+		"ACK",
+		"RESPONSE",
+	}[m]
+}
+
+type ResCode uint8
+
+const (
+	ResCodeError ResCode = iota
+	ResCodeOk
+	ResCodeInvalid
+	ResCodeLimit
+)
+
+func (m ResCode) String() string {
 	return [...]string{
 		"ERROR",
 		"OK",
@@ -179,13 +316,33 @@ func (m resCode) String() string {
 
 type component string
 
-// Component names for debug output
+// Component names, logged as "invoker".
+const (
+	CMD component = "command"
+	RPT component = "report"
+	CLI component = "client"
+)
+
+type LogLevel int8
+
 const (
-	CMD component = "[command] "
-	RPT component = "[report]  "
-	CLI component = "[client]  "
+	LogDebug LogLevel = iota
+	LogInfo
+	LogWarn
+	LogError
+	LogOff
 )
 
+func (m LogLevel) String() string {
+	return [...]string{
+		"DEBUG",
+		"INFO",
+		"WARN",
+		"ERROR",
+		"OFF",
+	}[m]
+}
+
 type VcuEvent uint8
 type VcuEvents []VcuEvent
 
@@ -411,3 +568,18 @@ func (m McuFaultRun) String() string {
 		"INV_DISCHARGE",
 	}[m]
 }
+
+// parseEnum find enum value in [min, limit) by its name or number.
+func parseEnum(text []byte, min, limit int, name func(int) string) (int, error) {
+	s := strings.ToUpper(strings.TrimSpace(string(text)))
+	for i := min; i < limit; i++ {
+		if name(i) == s {
+			return i, nil
+		}
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || i < min || i >= limit {
+		return 0, errInputOutOfRange(s)
+	}
+	return i, nil
+}
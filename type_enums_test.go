@@ -0,0 +1,41 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumUnmarshalText(t *testing.T) {
+	var args struct {
+		Frame     Frame
+		BikeState BikeState
+		Drive     ModeDrive
+		Trip      ModeTrip
+		Avg       ModeAvg
+	}
+	in := `{"Frame":"full","BikeState":"STANDBY","Drive":"Sport","Trip":"1","Avg":"efficiency"}`
+	if err := json.Unmarshal([]byte(in), &args); err != nil {
+		t.Fatal("want no error, got ", err)
+	}
+	if args.Frame != FrameFull || args.BikeState != BikeStateStandby || args.Drive != ModeDriveSport ||
+		args.Trip != ModeTripA || args.Avg != ModeAvgEfficiency {
+		t.Errorf("got %+v", args)
+	}
+
+	testCases := []struct {
+		desc string
+		in   string
+	}{
+		{desc: "unknown name", in: `{"Drive":"turbo"}`},
+		{desc: "limit", in: `{"Drive":"3"}`},
+		{desc: "below min", in: `{"Frame":"0"}`},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			err := json.Unmarshal([]byte(tC.in), &args)
+			if !IsInputOutOfRange(err) {
+				t.Errorf("want out of range error, got %v", err)
+			}
+		})
+	}
+}
@@ -20,11 +20,16 @@ var (
 	errClientDisconnected = errors.New("client disconnected")
 	errCmdNotFound        = errors.New("command not found")
 	errPacketAckCorrupt   = errors.New("packet ack corrupt")
+	errPacketCorrupt      = errors.New("packet corrupt")
 	errInvalidPrefix      = errors.New("invalid prefix")
 	errInvalidSize        = errors.New("invalid size")
 	errInvalidVin         = errors.New("invalid vin")
 	errInvalidCmdCode     = errors.New("invalid cmd code")
 	errInvalidResCode     = errors.New("invalid res code")
+	errInvalidVersion     = errors.New("invalid version")
+	errInvalidFrame       = errors.New("invalid frame")
+	errInvalidDatetime    = errors.New("invalid datetime")
+	errInvalidRecord      = errors.New("invalid record file")
 )
 
 type errPacketTimeout string
@@ -33,12 +38,75 @@ func (e errPacketTimeout) Error() string {
 	return fmt.Sprintf("packet %s timeout", string(e))
 }
 
+type errInvalidLength int
+
+func (e errInvalidLength) Error() string {
+	return fmt.Sprintf("invalid length %d", int(e))
+}
+
+type errFieldNotFound string
+
+func (e errFieldNotFound) Error() string {
+	return fmt.Sprintf("field %s not found", string(e))
+}
+
+type errInvalidConfig string
+
+func (e errInvalidConfig) Error() string {
+	return fmt.Sprintf("invalid config %s", string(e))
+}
+
+type errSubscribeFailed string
+
+func (e errSubscribeFailed) Error() string {
+	return fmt.Sprintf("subscribe %s failed", string(e))
+}
+
 type errInputOutOfRange string
 
 func (e errInputOutOfRange) Error() string {
 	return fmt.Sprintf("input %s out of range", string(e))
 }
 
+// errResponse is error reported by device on response packet.
+type errResponse struct {
+	code ResCode
+	msg  string
+}
+
+func (e errResponse) Error() string {
+	if e.msg == "" {
+		return e.code.String()
+	}
+	return fmt.Sprint(e.code, " ", e.msg)
+}
+
+// IsPacketTimeout check if err is caused by missing ack or response packet.
+func IsPacketTimeout(err error) bool {
+	var e errPacketTimeout
+	return errors.As(err, &e)
+}
+
+// IsInputOutOfRange check if err is caused by invalid command argument.
+func IsInputOutOfRange(err error) bool {
+	var e errInputOutOfRange
+	return errors.As(err, &e)
+}
+
+// IsClientDisconnected check if err is caused by disconnected mqtt client.
+func IsClientDisconnected(err error) bool {
+	return errors.Is(err, errClientDisconnected)
+}
+
+// ResponseCode return code of err reported by device on response packet.
+func ResponseCode(err error) (ResCode, bool) {
+	var e errResponse
+	if errors.As(err, &e) {
+		return e.code, true
+	}
+	return ResCodeOk, false
+}
+
 // Sleeper is building block for sleep things
 type Sleeper interface {
 	// Sleep pauses the current goroutine for at least the duration d.
@@ -3,17 +3,15 @@ package sdk
 import (
 	"encoding/hex"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"math/rand"
 	"os"
 	"os/signal"
 	"reflect"
-	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
-	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/garda-energi/gen.vcu.sdk/mqtt5"
 )
 
 // SetupGracefulShutdown wait until ctrl+c is pressed
@@ -30,15 +28,6 @@ func SetupGracefulShutdown() <-chan os.Signal {
 	return stopChan
 }
 
-// newLogger create new logger
-func newLogger(logging bool, prefix string) *log.Logger {
-	out := ioutil.Discard
-	if logging {
-		out = os.Stderr
-	}
-	return log.New(out, fmt.Sprint(prefix, " "), log.Ltime)
-}
-
 // byteToHex convert bytes to hex string
 func byteToHex(b []byte) string {
 	return strings.ToUpper(hex.EncodeToString(b))
@@ -69,11 +58,6 @@ func sliceToStr(s interface{}, prefix string) string {
 	return prefix + "[" + strings.Join(buf, ", ") + "]"
 }
 
-// debugPacket format received mqtt message
-func debugPacket(msg mqtt.Message) string {
-	return fmt.Sprintln(msg.Topic(), "=>", byteToHex(msg.Payload()))
-}
-
 // reverseBytes swap bytes position
 func reverseBytes(b []byte) []byte {
 	nb := make([]byte, len(b))
@@ -83,32 +67,23 @@ func reverseBytes(b []byte) []byte {
 	return nb
 }
 
-// toGlobalTopic replace VIN from topic with '+' character
-func toGlobalTopic(topic string) string {
-	s := strings.Split(topic, "/")
-	s[1] = "+"
-	return strings.Join(s, "/")
-}
-
-// getTopicVin extract VIN information from mqtt topic
-func getTopicVin(topic string) int {
-	s := strings.Split(topic, "/")
-	vin, _ := strconv.Atoi(s[1])
-	return vin
-}
-
-// setTopicVin insert VIN into topic pattern
-func setTopicVin(topic string, vin int) string {
-	return strings.Replace(topic, "+", strconv.Itoa(vin), 1)
+// matchTopics check if topic is matched by any of topic filters, see mqtt5.Match.
+func matchTopics(filters []string, topic string) bool {
+	for _, filter := range filters {
+		if mqtt5.Match(filter, topic) {
+			return true
+		}
+	}
+	return false
 }
 
-// setTopicVins create multiple topic for list of vin
-func setTopicVins(topic string, vins []int) []string {
-	topics := make([]string, len(vins))
-	for i, v := range vins {
-		topics[i] = setTopicVin(topic, v)
+// validDatetime check if device's datetime is sane,
+// it should not be too old nor too far in the future.
+func validDatetime(t time.Time) bool {
+	if t.Year() < REPORT_DATETIME_YEAR_MIN {
+		return false
 	}
-	return topics
+	return t.Before(time.Now().Add(REPORT_DATETIME_SKEW_MAX))
 }
 
 // randBool generate random boolean